@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+var checkConfigCommand = &Command{
+	Name:  "check-config",
+	Usage: "validate the configuration and print it: check-config [-ping]",
+	Run:   runCheckConfig,
+}
+
+// runCheckConfig is reached only with a valid configuration because main
+// validates it before running any command.
+func runCheckConfig(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runCheckConfig()"
+
+	fs := newFlagSet("check-config")
+	ping := fs.Bool("ping", false, "also connect to the configured storage")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(os.Stdout)
+	defer encoder.Close()
+	err := encoder.Encode(cfg.Redacted())
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	if *ping {
+		storage, err := createStorage(cfg)
+		if err != nil {
+			return fmt.Errorf("%s:%w", op, err)
+		}
+		storage.Close()
+	}
+
+	log.Info().Msgf("Configuration for %s storage is valid", cfg.Storage)
+	return nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+)
+
+type Command struct {
+	Name  string
+	Usage string
+	Run   func(ctx context.Context, cfg *config.Config, args []string) error
+}
+
+const DefaultCommand = "serve"
+
+var commands = []*Command{
+	serveCommand,
+	migrateCommand,
+	seedCommand,
+	exportCommand,
+	importCommand,
+	checkConfigCommand,
+}
+
+// Run executes the command named by the first argument, falling back to serve
+// when it is omitted so the binary keeps working without a subcommand.
+func Run(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.Run()"
+
+	name := DefaultCommand
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+
+	for _, cmd := range commands {
+		if cmd.Name == name {
+			err := cmd.Run(ctx, cfg, args)
+			if err != nil {
+				return fmt.Errorf("%s:%w", op, err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s:unknown command %q", op, name)
+}
+
+// PrintUsage writes the list of available commands.
+func PrintUsage(w io.Writer) {
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-14s %s\n", cmd.Name, cmd.Usage)
+	}
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage of %s:\n", name)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// NormalizeStorageType maps the short storage names to the full ones, unknown
+// names are returned as is and rejected by the config validation.
+func NormalizeStorageType(storageType string) string {
+	switch strings.ToLower(storageType) {
+	case "postgres", "p":
+		return config.StoragePostgres
+	case "memory", "m":
+		return config.StorageMemory
+	default:
+		return storageType
+	}
+}
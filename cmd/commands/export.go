@@ -0,0 +1,323 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// dump is the storage independent format used by export, import and seed.
+// Comments are nested, so ids are not preserved on import, the creation dates
+// are.
+type dump struct {
+	Posts []*dumpPost `json:"posts"`
+}
+
+type dumpPost struct {
+	ID               int64          `json:"id,omitempty"`
+	AuthorID         uuid.UUID      `json:"authorID"`
+	Title            string         `json:"title"`
+	Text             string         `json:"text"`
+	CommentsEnabled  bool           `json:"commentsEnabled"`
+	CreateDate       time.Time      `json:"createDate"`
+	Status           string         `json:"status,omitempty"`
+	PublishAt        *time.Time     `json:"publishAt,omitempty"`
+	Format           string         `json:"format,omitempty"`
+	Tags             []string       `json:"tags,omitempty"`
+	MaxCommentDepth  *int           `json:"maxCommentDepth,omitempty"`
+	CommentDepthMode *string        `json:"commentDepthMode,omitempty"`
+	Comments         []*dumpComment `json:"comments,omitempty"`
+}
+
+type dumpComment struct {
+	ID         int64          `json:"id,omitempty"`
+	AuthorID   uuid.UUID      `json:"authorID"`
+	Text       string         `json:"text"`
+	Format     string         `json:"format,omitempty"`
+	CreateDate time.Time      `json:"createDate"`
+	Replies    []*dumpComment `json:"replies,omitempty"`
+}
+
+var exportCommand = &Command{
+	Name:  "export",
+	Usage: "write all posts and comments as JSON: export [-o file]",
+	Run:   runExport,
+}
+
+var importCommand = &Command{
+	Name:  "import",
+	Usage: "load posts and comments from JSON made by export: import [-i file]",
+	Run:   runImport,
+}
+
+func runExport(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runExport()"
+
+	fs := newFlagSet("export")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	storage, err := createStorage(cfg)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	defer storage.Close()
+
+	data, err := exportStorage(ctx, storage)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("%s:%w", op, err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(data)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Info().Msgf("Exported %d posts", len(data.Posts))
+	return nil
+}
+
+func runImport(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runImport()"
+
+	fs := newFlagSet("import")
+	input := fs.String("i", "", "input file (default stdin)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		file, err := os.Open(*input)
+		if err != nil {
+			return fmt.Errorf("%s:%w", op, err)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var data dump
+	err := json.NewDecoder(r).Decode(&data)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	storage, err := createStorage(cfg)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	defer storage.Close()
+
+	err = importDump(ctx, storage, &data)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Info().Msgf("Imported %d posts", len(data.Posts))
+	return nil
+}
+
+func exportStorage(ctx context.Context, storage storage.StorageImp) (*dump, error) {
+	posts, err := storage.GetAllPostsUnfiltered(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]int64, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+	tags, err := storage.GetPostTags(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &dump{Posts: make([]*dumpPost, 0, len(posts))}
+	for _, post := range posts {
+		comments, err := exportComments(ctx, storage, post.ID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		data.Posts = append(data.Posts, &dumpPost{
+			ID:               post.ID,
+			AuthorID:         post.AuthorID,
+			Title:            post.Title,
+			Text:             post.Text,
+			CommentsEnabled:  post.CommentsEnabled,
+			CreateDate:       post.CreateDate,
+			Status:           string(post.Status),
+			PublishAt:        post.PublishAt,
+			Format:           string(post.Format),
+			Tags:             tags[post.ID],
+			MaxCommentDepth:  post.MaxCommentDepth,
+			CommentDepthMode: (*string)(post.CommentDepthMode),
+			Comments:         comments,
+		})
+	}
+
+	return data, nil
+}
+
+func exportComments(ctx context.Context, storage storage.StorageImp, postID int64, path string) ([]*dumpComment, error) {
+	comments, err := storage.GetCommentsBranch(ctx, postID, path)
+	if err != nil {
+		if errors.Is(err, errs.ErrCommentsNotExist) || errors.Is(err, errs.ErrPathNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := make([]*dumpComment, 0, len(comments))
+	for _, comment := range comments {
+		replies, err := exportComments(ctx, storage, postID, comment.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &dumpComment{
+			ID:         comment.ID,
+			AuthorID:   comment.AuthorID,
+			Text:       comment.Text,
+			Format:     string(comment.Format),
+			CreateDate: comment.CreateDate,
+			Replies:    replies,
+		})
+	}
+
+	return result, nil
+}
+
+func importDump(ctx context.Context, storage storage.StorageImp, data *dump) error {
+	// the whole dump is checked first, so a bad record doesn't leave it half
+	// imported
+	for i, p := range data.Posts {
+		err := checkPublication(postStatus(p.Status), p.PublishAt)
+		if err != nil {
+			return fmt.Errorf("posts[%d]:%w", i, err)
+		}
+	}
+
+	for _, p := range data.Posts {
+		// comments are enabled while importing, otherwise they can't be added
+		post, err := storage.AddPost(ctx, &model.NewPost{
+			AuthorID:         p.AuthorID,
+			Title:            p.Title,
+			Text:             p.Text,
+			CommentsEnabled:  true,
+			Status:           postStatus(p.Status),
+			PublishAt:        p.PublishAt,
+			Format:           textFormat(p.Format),
+			Tags:             p.Tags,
+			MaxCommentDepth:  p.MaxCommentDepth,
+			CommentDepthMode: (*model.DepthMode)(p.CommentDepthMode),
+			CreateDate:       p.CreateDate,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = importComments(ctx, storage, post.ID, nil, p.Comments)
+		if err != nil {
+			return err
+		}
+
+		if !p.CommentsEnabled {
+			_, err = storage.UpdateEnableCommentToPost(ctx, post.ID, post.AuthorID, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func importComments(ctx context.Context, storage storage.StorageImp, postID int64, parentID *int64, comments []*dumpComment) error {
+	for _, c := range comments {
+		comment, err := storage.AddComment(ctx, postID, &model.NewComment{
+			AuthorID:   c.AuthorID,
+			PostID:     postID,
+			ParentID:   parentID,
+			Text:       c.Text,
+			Format:     textFormat(c.Format),
+			CreateDate: c.CreateDate,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = importComments(ctx, storage, postID, &comment.ID, c.Replies)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// textFormat returns the format of the dump, the dumps made before the
+// formats have plain texts.
+func textFormat(format string) model.TextFormat {
+	if format == "" {
+		return model.FormatPlain
+	}
+	return model.TextFormat(format)
+}
+
+// postStatus returns the status of the dump, the dumps made before the
+// statuses have published posts only.
+func postStatus(status string) model.PostStatus {
+	if status == "" {
+		return model.PostPublished
+	}
+	return model.PostStatus(status)
+}
+
+// checkPublication applies the rules of addPost to the status and publishAt of
+// a dumped post: only a scheduled post must have publishAt, a published one
+// may keep its publication time. A past publishAt of a scheduled post is
+// allowed, the scheduler publishes it.
+func checkPublication(status model.PostStatus, publishAt *time.Time) error {
+	switch status {
+	case model.PostPublished:
+		return nil
+	case model.PostScheduled:
+		if publishAt == nil {
+			return errs.ErrInvalidPublishAt
+		}
+		return nil
+	case model.PostDraft:
+		if publishAt != nil {
+			return errs.ErrInvalidPublishAt
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown post status %q", status)
+	}
+}
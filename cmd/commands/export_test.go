@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	inmemory "github.com/nabishec/ozon_habr_api/internal/storage/in-memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	authorID := uuid.New()
+	created := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	published, scheduled := created.Add(time.Hour), created.Add(24*time.Hour)
+	depth, flatten := 2, "flatten"
+
+	data := &dump{Posts: []*dumpPost{
+		{
+			AuthorID:         authorID,
+			Title:            "Markdown post",
+			Text:             "**text**",
+			CommentsEnabled:  false,
+			CreateDate:       created,
+			Status:           "published",
+			PublishAt:        &created,
+			Format:           "markdown",
+			Tags:             []string{"go", "graphql"},
+			MaxCommentDepth:  &depth,
+			CommentDepthMode: &flatten,
+			Comments: []*dumpComment{
+				{
+					AuthorID:   authorID,
+					Text:       "_comment_",
+					Format:     "markdown",
+					CreateDate: created.Add(time.Hour),
+					Replies: []*dumpComment{
+						{AuthorID: authorID, Text: "reply", Format: "plain", CreateDate: created.Add(2 * time.Hour)},
+					},
+				},
+			},
+		},
+		{
+			AuthorID:        authorID,
+			Title:           "Plain post",
+			Text:            "text",
+			CommentsEnabled: true,
+			CreateDate:      created.Add(time.Minute),
+			Status:          "published",
+			PublishAt:       &published,
+			Format:          "plain",
+		},
+		{
+			AuthorID:        authorID,
+			Title:           "Draft",
+			Text:            "text",
+			CommentsEnabled: true,
+			CreateDate:      created.Add(2 * time.Minute),
+			Status:          "draft",
+			Format:          "plain",
+		},
+		{
+			AuthorID:        authorID,
+			Title:           "Scheduled post",
+			Text:            "text",
+			CommentsEnabled: true,
+			CreateDate:      created.Add(3 * time.Minute),
+			Status:          "scheduled",
+			PublishAt:       &scheduled,
+			Format:          "plain",
+		},
+	}}
+
+	storage := inmemory.NewStorage()
+	require.NoError(t, importDump(ctx, storage, data))
+
+	exported, err := exportStorage(ctx, storage)
+	require.NoError(t, err)
+	clearIDs(exported)
+	assert.Equal(t, data, exported)
+}
+
+func TestImportOldDump(t *testing.T) {
+	ctx := context.Background()
+	storage := inmemory.NewStorage()
+
+	err := importDump(ctx, storage, &dump{Posts: []*dumpPost{{AuthorID: uuid.New(), Title: "t", Text: "t", CommentsEnabled: true}}})
+	require.NoError(t, err)
+
+	post, err := storage.GetPost(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, model.FormatPlain, post.Format)
+	assert.Equal(t, model.PostPublished, post.Status)
+	assert.False(t, post.CreateDate.IsZero(), "a dump without dates is imported with the current time")
+}
+
+func TestImportInvalidPublication(t *testing.T) {
+	ctx := context.Background()
+	publishAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		status    string
+		publishAt *time.Time
+		want      error
+	}{
+		{name: "Unknown status", status: "archived"},
+		{name: "Scheduled without publishAt", status: "scheduled", want: errs.ErrInvalidPublishAt},
+		{name: "Draft with publishAt", status: "draft", publishAt: &publishAt, want: errs.ErrInvalidPublishAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := inmemory.NewStorage()
+			data := &dump{Posts: []*dumpPost{
+				{AuthorID: uuid.New(), Title: "t", Text: "t", CommentsEnabled: true},
+				{AuthorID: uuid.New(), Title: "t", Text: "t", CommentsEnabled: true, Status: tt.status, PublishAt: tt.publishAt},
+			}}
+
+			err := importDump(ctx, storage, data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "posts[1]")
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			}
+
+			posts, err := storage.GetAllPostsUnfiltered(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, posts, "nothing is imported from an invalid dump")
+		})
+	}
+}
+
+func clearIDs(data *dump) {
+	var clearComments func(comments []*dumpComment)
+	clearComments = func(comments []*dumpComment) {
+		for _, comment := range comments {
+			comment.ID = 0
+			clearComments(comment.Replies)
+		}
+	}
+	for _, post := range data.Posts {
+		post.ID = 0
+		clearComments(post.Comments)
+	}
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	dbconnection "github.com/nabishec/ozon_habr_api/cmd/db_connection"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/pressly/goose/v3"
+	"github.com/rs/zerolog/log"
+)
+
+var migrateCommand = &Command{
+	Name:  "migrate",
+	Usage: "apply database migrations: migrate [-dir migrations] [up|down|status|version|redo|reset]",
+	Run:   runMigrate,
+}
+
+func runMigrate(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runMigrate()"
+
+	fs := newFlagSet("migrate")
+	dir := fs.String("dir", cfg.Database.MigrationsDir, "directory with migration files")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// the in-memory storage has no schema, so there is nothing to migrate
+	if cfg.Storage != config.StoragePostgres {
+		return fmt.Errorf("%s:migrations require postgres storage", op)
+	}
+
+	command := "up"
+	var commandArgs []string
+	if fs.NArg() > 0 {
+		command = fs.Arg(0)
+		commandArgs = fs.Args()[1:]
+	}
+
+	dbConn, err := dbconnection.NewDatabaseConnection(cfg.Database)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	defer dbConn.CloseDatabase()
+
+	err = goose.SetDialect("postgres")
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Info().Msgf("Running migrations %s from %s", command, *dir)
+	err = goose.RunContext(ctx, command, dbConn.DB.DB, *dir, commandArgs...)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+var seedCommand = &Command{
+	Name:  "seed",
+	Usage: "fill the storage with test posts and comments",
+	Run:   runSeed,
+}
+
+func runSeed(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runSeed()"
+
+	fs := newFlagSet("seed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if cfg.Storage == config.StorageMemory {
+		log.Warn().Msg("Seeding in-memory storage, data will be lost when the command exits")
+	}
+
+	storage, err := createStorage(cfg)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	defer storage.Close()
+
+	data := seedData()
+	err = importDump(ctx, storage, data)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Info().Msgf("Seeded %d posts", len(data.Posts))
+	return nil
+}
+
+// seedData is the same test thread that is kept commented out in the first migration.
+func seedData() *dump {
+	return &dump{
+		Posts: []*dumpPost{
+			{
+				AuthorID:        uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"),
+				Title:           "Тестовый пост про SQL",
+				Text:            "Этот пост создан для проверки API комментариев",
+				CommentsEnabled: true,
+				Comments: []*dumpComment{
+					{
+						AuthorID: uuid.MustParse("b1eebc99-9c0b-4ef8-bb6d-6bb9bd380a22"),
+						Text:     "Первый комментарий к посту!",
+						Replies: []*dumpComment{
+							{
+								AuthorID: uuid.MustParse("d3eebc99-9c0b-4ef8-bb6d-6bb9bd380a44"),
+								Text:     "Это ответ на первый комментарий",
+								Replies: []*dumpComment{
+									{
+										AuthorID: uuid.MustParse("e4eebc99-9c0b-4ef8-bb6d-6bb9bd380a55"),
+										Text:     "А это ответ на ответ (уровень 3)",
+									},
+								},
+							},
+							{
+								AuthorID: uuid.MustParse("f5eebc99-9c0b-4ef8-bb6d-6bb9bd380a66"),
+								Text:     "Ещё один ответ к первому комментарию",
+							},
+						},
+					},
+					{
+						AuthorID: uuid.MustParse("c2eebc99-9c0b-4ef8-bb6d-6bb9bd380a33"),
+						Text:     "Второй корневой комментарий",
+						Replies: []*dumpComment{
+							{
+								AuthorID: uuid.MustParse("a6eebc99-9c0b-4ef8-bb6d-6bb9bd380a77"),
+								Text:     "Ответ на второй корневой комментарий",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
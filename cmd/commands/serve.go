@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nabishec/ozon_habr_api/cmd/server"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/metrics"
+	"github.com/nabishec/ozon_habr_api/internal/tracing"
+	"github.com/rs/zerolog/log"
+)
+
+var serveCommand = &Command{
+	Name:  "serve",
+	Usage: "start the GraphQL server (default)",
+	Run:   runServe,
+}
+
+func runServe(ctx context.Context, cfg *config.Config, args []string) error {
+	const op = "cmd.commands.runServe()"
+
+	fs := newFlagSet("serve")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	defer func() {
+		// ctx is already canceled here, the pending spans get their own deadline
+		flushCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			log.Warn().Err(err).Msg("Failed to flush traces")
+		}
+	}()
+
+	//creating storage according to the settings of the parameters
+	storage, err := createStorage(cfg)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+
+	metrics := metrics.New()
+	if storage.dbStorage != nil {
+		storage.dbStorage.SetCacheObserver(metrics)
+	}
+
+	err = server.RunServer(ctx, storage, cfg, server.Resources{
+		DB:      storage.dbConn,
+		Cache:   storage.cacheConn,
+		Metrics: metrics,
+	})
+
+	// the storage is closed only after the server has drained active requests
+	errClose := storage.Close()
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	if errClose != nil {
+		return fmt.Errorf("%s:%w", op, errClose)
+	}
+
+	return nil
+}
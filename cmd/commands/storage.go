@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	dbconnection "github.com/nabishec/ozon_habr_api/cmd/db_connection"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/storage"
+	"github.com/nabishec/ozon_habr_api/internal/storage/db"
+	inmemory "github.com/nabishec/ozon_habr_api/internal/storage/in-memory"
+)
+
+// storageConn is the configured storage together with the connections behind
+// it, the connections are nil for the in-memory storage.
+type storageConn struct {
+	storage.StorageImp
+
+	dbStorage *db.Storage
+	dbConn    *dbconnection.DatabaseConnection
+	cacheConn *dbconnection.Cache
+}
+
+func (s *storageConn) Close() error {
+	var errDB, errCache error
+	if s.dbConn != nil {
+		errDB = s.dbConn.CloseDatabase()
+	}
+	if s.cacheConn != nil {
+		errCache = s.cacheConn.CloseCache()
+	}
+	return errors.Join(errDB, errCache)
+}
+
+func createStorage(cfg *config.Config) (*storageConn, error) {
+
+	if cfg.Storage == config.StorageMemory {
+		return createResolverInMemory()
+
+	} else {
+		return createResolverWithDB(cfg)
+	}
+}
+
+func createResolverInMemory() (*storageConn, error) {
+	inmemory := inmemory.NewStorage()
+
+	return &storageConn{StorageImp: inmemory}, nil
+}
+
+func createResolverWithDB(cfg *config.Config) (*storageConn, error) {
+	const op = "cmd.commands.createResolverWithDB()"
+	dbConn, err := dbconnection.NewDatabaseConnection(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	cacheConn, err := dbconnection.NewCacheConnection(cfg.Cache)
+	if err != nil {
+		dbConn.CloseDatabase()
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	storage := db.NewStorage(dbConn.DB, cacheConn.Cache, cfg.Cache.TTL)
+
+	return &storageConn{
+		StorageImp: storage,
+		dbStorage:  storage,
+		dbConn:     dbConn,
+		cacheConn:  cacheConn,
+	}, nil
+}
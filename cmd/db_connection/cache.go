@@ -2,56 +2,103 @@ package dbconnection
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
-	"time"
+	"slices"
 
 	"github.com/go-redis/cache/v9"
+	"github.com/nabishec/ozon_habr_api/internal/config"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 )
 
 type Cache struct {
 	Cache *cache.Cache
-}
+	Ring  *redis.Ring
 
-const DefaultRedisDB = 0
+	// shards are separate clients for the health checks, the ring skips shards
+	// that are down and can't report them
+	shards map[string]*redis.Client
+}
 
-func NewCacheConnection() (*Cache, error) {
+func NewCacheConnection(cfg config.Cache) (*Cache, error) {
 	const op = "cmd.dbconnection.NewCacheConnection()"
 
-	redisDB, err := strconv.Atoi(os.Getenv("REDIS_DB"))
-	if err != nil {
-		log.Warn().Msg("Failed get redis db from env")
-		redisDB = DefaultRedisDB
-	}
 	ring := redis.NewRing(&redis.RingOptions{
-		Addrs: map[string]string{
-			"shard1": os.Getenv("REDIS_HOST1") + ":" + os.Getenv("REDIS_PORT1"),
-			"shard2": os.Getenv("REDIS_HOST2") + ":" + os.Getenv("REDIS_PORT2"),
-		},
+		Addrs: cfg.Shards,
 
-		DB: redisDB,
+		DB: cfg.DB,
 
-		Password: os.Getenv("REDIS_PASSWORD"),
+		Password: cfg.Password,
 
-		MaxRetries: 3,
+		MaxRetries: cfg.MaxRetries,
 
-		DialTimeout: 50 * time.Millisecond,
+		DialTimeout: cfg.DialTimeout,
 	})
 
 	if err := ring.Ping(context.TODO()).Err(); err != nil {
-		return nil, fmt.Errorf("failed to ping Redis: %v", err)
+		return nil, fmt.Errorf("%s:failed to ping Redis: %w", op, err)
 	}
 
 	cache := &Cache{
 		Cache: cache.New(&cache.Options{
 			Redis:      ring,
-			LocalCache: cache.NewTinyLFU(1000, time.Minute),
+			LocalCache: cache.NewTinyLFU(cfg.LocalCacheSize, cfg.LocalCacheTTL),
 		}),
+		Ring:   ring,
+		shards: make(map[string]*redis.Client, len(cfg.Shards)),
+	}
+
+	for name, addr := range cfg.Shards {
+		cache.shards[name] = redis.NewClient(&redis.Options{
+			Addr:        addr,
+			DB:          cfg.DB,
+			Password:    cfg.Password,
+			DialTimeout: cfg.DialTimeout,
+			PoolSize:    1,
+		})
 	}
 
 	return cache, nil
 
 }
+
+func (c *Cache) CloseCache() error {
+	const op = "cmd.dbconnection.CloseCache()"
+
+	log.Info().Msg("Attempting to close cache")
+	var closingError = c.Ring.Close()
+	for _, shard := range c.shards {
+		closingError = errors.Join(closingError, shard.Close())
+	}
+	if closingError != nil {
+		return fmt.Errorf("%s:%w", op, closingError)
+	}
+	log.Info().Msg("Successful closing of cache")
+	return nil
+}
+
+// ShardNames returns the names of the configured ring shards.
+func (c *Cache) ShardNames() []string {
+	names := make([]string, 0, len(c.shards))
+	for name := range c.shards {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (c *Cache) PingShard(ctx context.Context, name string) error {
+	const op = "cmd.dbconnection.PingShard()"
+
+	shard, ok := c.shards[name]
+	if !ok {
+		return fmt.Errorf("%s:unknown shard %s", op, name)
+	}
+
+	err := shard.Ping(ctx).Err()
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	return nil
+}
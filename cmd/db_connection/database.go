@@ -1,11 +1,14 @@
 package dbconnection
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
-	"os"
 
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/jmoiron/sqlx"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/pressly/goose/v3"
 
 	"github.com/rs/zerolog/log"
 )
@@ -15,18 +18,13 @@ type DatabaseConnection struct {
 	DB             *sqlx.DB
 }
 
-func NewDatabaseConnection() (*DatabaseConnection, error) {
+func NewDatabaseConnection(cfg config.Database) (*DatabaseConnection, error) {
 	log.Info().Msg("Connecting to database")
 
 	log.Debug().Msg("Init database")
 	var databaseCon DatabaseConnection
 
-	config, err := newDSN()
-	if err != nil {
-		return nil, err
-	}
-
-	err = databaseCon.connectDatabase(config)
+	err := databaseCon.connectDatabase(cfg.DSN())
 	if err != nil {
 		return nil, err
 	}
@@ -52,23 +50,38 @@ func (db *DatabaseConnection) connectDatabase(config string) error {
 	return nil
 }
 
-func (db *DatabaseConnection) PingDatabase() error {
+func (db *DatabaseConnection) PingDatabase(ctx context.Context) error {
 	const op = "cmd.dbconnection.PingDatabase()"
 
-	log.Info().Msg("Attempting to ping Database")
+	log.Debug().Msg("Attempting to ping Database")
 	if db.DB == nil {
 		return fmt.Errorf("%s:%s", op, "database isn`t established")
 	}
 
-	var pingError = db.DB.Ping()
+	var pingError = db.DB.PingContext(ctx)
 	if pingError != nil {
 		return fmt.Errorf("%s:%w", op, pingError)
 	}
 
-	log.Info().Msg("Ping database is successful")
+	log.Debug().Msg("Ping database is successful")
 	return nil
 }
 
+// MigrationVersion returns the last applied goose migration, unlike goose
+// itself it never creates the version table.
+func (db *DatabaseConnection) MigrationVersion(ctx context.Context) (int64, error) {
+	const op = "cmd.dbconnection.MigrationVersion()"
+
+	var version sql.NullInt64
+	query := `SELECT MAX(version_id) FROM ` + goose.TableName() + ` WHERE is_applied`
+	err := db.DB.GetContext(ctx, &version, query)
+	if err != nil {
+		return 0, fmt.Errorf("%s:%w", op, err)
+	}
+
+	return version.Int64, nil
+}
+
 func (db *DatabaseConnection) CloseDatabase() error {
 	const op = "cmd.dbconnection.CloseDatabase()"
 
@@ -80,49 +93,3 @@ func (db *DatabaseConnection) CloseDatabase() error {
 	log.Info().Msg("Successful closing of database")
 	return nil
 }
-
-func newDSN() (string, error) {
-	const op = "cmd.dbconnection.NewDSN()"
-
-	log.Debug().Msg("Reading dsn from env variables")
-	dsnProtocol := os.Getenv("DB_PROTOCOL")
-	if dsnProtocol == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_PROTOCOL isn't set")
-	}
-
-	dsnUserName := os.Getenv("DB_USER")
-	if dsnUserName == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_USER isn't set")
-	}
-
-	dsnPassword := os.Getenv("DB_PASSWORD")
-	if dsnPassword == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_PASSWORD isn't set")
-	}
-
-	dsnHost := os.Getenv("DB_HOST")
-	if dsnHost == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_HOST isn't set")
-	}
-
-	dsnPort := os.Getenv("DB_PORT")
-	if dsnPort == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_PORT isn't set")
-	}
-
-	dsnDBName := os.Getenv("DB_NAME")
-	if dsnDBName == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_NAME isn't set")
-	}
-
-	dsnOptions := os.Getenv("DB_OPTIONS")
-	if dsnOptions == "" {
-		return "", fmt.Errorf("%s:%s", op, "DB_OPTIONS isn't set")
-	}
-
-	dsn := dsnProtocol + "://" + dsnUserName + ":" + dsnPassword + "@" +
-		dsnHost + ":" + dsnPort + "/" + dsnDBName + "?" + dsnOptions
-
-	log.Debug().Msgf("Reading dsn is successful dsn = %s", dsn)
-	return dsn, nil
-}
@@ -1,70 +1,90 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/joho/godotenv"
-	dbconnection "github.com/nabishec/ozon_habr_api/cmd/db_connection"
-	"github.com/nabishec/ozon_habr_api/cmd/server"
-	"github.com/nabishec/ozon_habr_api/internal/storage"
-	"github.com/nabishec/ozon_habr_api/internal/storage/db"
-	inmemory "github.com/nabishec/ozon_habr_api/internal/storage/in-memory"
+	"github.com/nabishec/ozon_habr_api/cmd/commands"
+	"github.com/nabishec/ozon_habr_api/internal/config"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 func main() {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to the yaml configuration file")
 	debug := flag.Bool("d", false, "set log level to debug")
 	easyReading := flag.Bool("r", false, "set console writer")
+	port := flag.Int("port", 0, "set server port")
 
 	var storageType string
-	const defaultStorageType = "postgres"
 	flag.StringVar(&storageType, "storage", "postgres", "set storage type 'memory'('m') or postgres('p')")
 	flag.StringVar(&storageType, "s", "p", "set storage type 'memory'('m') or postgres('p')")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [command] [command flags]\n", os.Args[0])
+		flag.PrintDefaults()
+		commands.PrintUsage(flag.CommandLine.Output())
+	}
 	flag.Parse()
 
+	//load enviroments
+	err := loadEnv()
+	if err != nil {
+		log.Warn().Err(err).Msg("Env file isn't loaded, using environment and config file only")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed load configuration")
+		os.Exit(1)
+	}
+
+	// flags have the highest priority, so only the explicitly set ones are applied
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "d":
+			cfg.Log.Debug = *debug
+		case "r":
+			cfg.Log.Console = *easyReading
+		case "port":
+			cfg.Server.Port = *port
+		case "s", "storage":
+			cfg.Storage = commands.NormalizeStorageType(storageType)
+		}
+	})
+
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	if *debug {
+	if cfg.Log.Debug {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	}
 
 	// for reading logs
-	if *easyReading {
+	if cfg.Log.Console {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
+	// log.Ctx(ctx) falls back to the global logger outside of requests
+	zerolog.DefaultContextLogger = &log.Logger
 
-	switch storageType {
-	case "postgres":
-	case "memory":
-	case "p":
-		storageType = "postgres"
-	case "m":
-		storageType = "memory"
-	default:
-		log.Error().Msg("Storage type is incorrectly selected")
-		storageType = defaultStorageType
-		log.Warn().Msg("Default storage type is selected for further work")
-	}
-
-	//load enviroments
-	err := loadEnv()
+	err = cfg.Validate()
 	if err != nil {
-		log.Error().Err(err).Msg("Don't found configuration")
+		log.Error().Err(err).Msg("Invalid configuration")
 		os.Exit(1)
 	}
 
-	//creating storage according to the settings of the parameters
-	storage, err := createStorage(storageType)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err = commands.Run(ctx, cfg, flag.Args())
 	if err != nil {
-		log.Error().Err(err).Msg("Failed init storage")
+		log.Error().Err(err).Msg("Command failed")
+		stop()
 		os.Exit(1)
 	}
-
-	server.RunServer(storage)
-	//TODO: RUN SERVER
 }
 
 func loadEnv() error {
@@ -75,38 +95,3 @@ func loadEnv() error {
 	}
 	return nil
 }
-
-func createStorage(storageType string) (storage.StorageImp, error) {
-
-	if storageType == "memory" {
-		return createResolverInMemory()
-
-	} else {
-		return createResolverWithDB()
-	}
-}
-
-func createResolverInMemory() (storage.StorageImp, error) {
-	const op = "cmd.createResolverInMemory()"
-
-	inmemory := inmemory.NewStorage()
-
-	return inmemory, nil
-}
-
-func createResolverWithDB() (storage.StorageImp, error) {
-	const op = "cmd.createDBStorage()"
-	dbConn, err := dbconnection.NewDatabaseConnection()
-	if err != nil {
-		return nil, fmt.Errorf("%s:%w", op, err)
-	}
-
-	cacheConn, err := dbconnection.NewCacheConnection()
-	if err != nil {
-		return nil, fmt.Errorf("%s:%w", op, err)
-	}
-
-	storage := db.NewStorage(dbConn.DB, cacheConn.Cache)
-
-	return storage, nil
-}
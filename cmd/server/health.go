@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dbconnection "github.com/nabishec/ozon_habr_api/cmd/db_connection"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/health"
+	"github.com/nabishec/ozon_habr_api/internal/metrics"
+	"github.com/pressly/goose/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// Resources are the shared dependencies of the server. The connections
+// behind the storage are nil for the in-memory storage.
+type Resources struct {
+	DB      *dbconnection.DatabaseConnection
+	Cache   *dbconnection.Cache
+	Metrics *metrics.Metrics
+}
+
+type migrationStatus struct {
+	Version  int64 `json:"version"`
+	Expected int64 `json:"expected,omitempty"`
+}
+
+func readinessChecks(cfg *config.Config, res Resources) []health.Check {
+	var checks []health.Check
+
+	if res.DB != nil {
+		checks = append(checks, health.Check{
+			Name:     "postgres",
+			Critical: true,
+			Run: func(ctx context.Context) (any, error) {
+				return nil, res.DB.PingDatabase(ctx)
+			},
+		})
+
+		expected := expectedMigrationVersion(cfg.Database.MigrationsDir)
+		checks = append(checks, health.Check{
+			Name:     "migrations",
+			Critical: true,
+			Run: func(ctx context.Context) (any, error) {
+				version, err := res.DB.MigrationVersion(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				status := &migrationStatus{Version: version, Expected: expected}
+				if version < expected {
+					return status, errors.New("database schema is behind the migrations")
+				}
+				return status, nil
+			},
+		})
+	}
+
+	// storage falls back to the database when the cache fails, so the shards aren't critical
+	if res.Cache != nil {
+		for _, name := range res.Cache.ShardNames() {
+			checks = append(checks, health.Check{
+				Name: "redis:" + name,
+				Run: func(ctx context.Context) (any, error) {
+					return nil, res.Cache.PingShard(ctx, name)
+				},
+			})
+		}
+	}
+
+	return checks
+}
+
+// expectedMigrationVersion returns the last migration in dir or 0 when it can't
+// be read, then only the applied version is reported.
+func expectedMigrationVersion(dir string) int64 {
+	const op = "cmd.server.expectedMigrationVersion()"
+
+	migrations, err := goose.CollectMigrations(dir, 0, goose.MaxVersion)
+	if err != nil {
+		log.Warn().Err(fmt.Errorf("%s:%w", op, err)).Msg("Migration version won't be compared")
+		return 0
+	}
+
+	last, err := migrations.Last()
+	if err != nil {
+		log.Warn().Err(fmt.Errorf("%s:%w", op, err)).Msg("Migration version won't be compared")
+		return 0
+	}
+
+	return last.Version
+}
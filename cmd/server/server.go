@@ -1,89 +1,247 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
-	"os"
 	"slices"
-	"time"
+	"strconv"
 
 	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog/log"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/nabishec/ozon_habr_api/graph"
+	"github.com/nabishec/ozon_habr_api/internal/accesslog"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	activityquery "github.com/nabishec/ozon_habr_api/internal/handlers/activity_query"
 	commentmutation "github.com/nabishec/ozon_habr_api/internal/handlers/comment_mutation"
 	commentquery "github.com/nabishec/ozon_habr_api/internal/handlers/comment_query"
 	postmutation "github.com/nabishec/ozon_habr_api/internal/handlers/post_mutation"
 	postquery "github.com/nabishec/ozon_habr_api/internal/handlers/post_query"
+	reactionmutation "github.com/nabishec/ozon_habr_api/internal/handlers/reaction_mutation"
+	reactionquery "github.com/nabishec/ozon_habr_api/internal/handlers/reaction_query"
+	searchquery "github.com/nabishec/ozon_habr_api/internal/handlers/search_query"
+	"github.com/nabishec/ozon_habr_api/internal/health"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/persisted"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/requestid"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+	"github.com/nabishec/ozon_habr_api/internal/querylimit"
+	"github.com/nabishec/ozon_habr_api/internal/ratelimit"
+	"github.com/nabishec/ozon_habr_api/internal/render"
+	"github.com/nabishec/ozon_habr_api/internal/scheduler"
 	"github.com/nabishec/ozon_habr_api/internal/storage"
+	"github.com/nabishec/ozon_habr_api/internal/tracing"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
-const defaultPort = "8080"
-
-func RunServer(storage storage.StorageImp) {
+// RunServer serves the GraphQL api until ctx is canceled and then shuts the
+// server down gracefully within the shutdown timeout.
+func RunServer(ctx context.Context, storage storage.StorageImp, appConfig *config.Config, res Resources) error {
 	op := "cmd.server.RunServer()"
-	port := os.Getenv("SERVER_PORT")
-	if port == "" {
-		port = defaultPort
+	cfg := appConfig.Server
+	port := strconv.Itoa(cfg.Port)
+
+	storage = tracing.NewStorage(res.Metrics.Storage(storage))
+	if res.DB != nil {
+		res.Metrics.RegisterDBStats(res.DB.DB.DB, appConfig.Database.Name)
+	}
+
+	limits := validate.Limits{
+		TitleMaxLength:       appConfig.Validation.TitleMaxLength,
+		PostTextMaxLength:    appConfig.Validation.PostTextMaxLength,
+		CommentTextMaxLength: appConfig.Validation.CommentTextMaxLength,
 	}
 
-	postMutation := postmutation.NewPostMutation(storage)
+	postMutation := postmutation.NewPostMutation(storage, limits)
 	postQuery := postquery.NewPostQuery(storage)
-	commentMutation := commentmutation.NewCommentMutation(storage)
+	commentMutation := commentmutation.NewCommentMutation(storage, limits, model.CommentDepth{
+		Max:  appConfig.Comments.MaxDepth,
+		Mode: model.DepthMode(appConfig.Comments.DepthMode),
+	})
 	commentQuery := commentquery.NewCommentQuery(storage)
+	reactionMutation := reactionmutation.NewReactionMutation(storage, appConfig.Reactions.Allowed)
+	reactionQuery := reactionquery.NewReactionQuery(storage)
+	searchQuery := searchquery.NewSearchQuery(storage)
+	activityQuery := activityquery.NewActivityQuery(storage)
 
-	resolver := graph.NewResolver(postMutation, postQuery, commentMutation, commentQuery)
-	c := graph.Config{Resolvers: resolver}
-
-	countComplexityComment := func(childComplexity int, first *int32, after *string) int {
-		return int(*first) * childComplexity
-	}
-	countComplexityReplice := func(childComplexity int, first *int32, after *string) int {
-		return int(*first) * childComplexity
+	var htmlCache render.Cache = lru.New[string](appConfig.Posts.HTMLCacheSize)
+	if res.Cache != nil {
+		htmlCache = render.NewRedisCache(res.Cache.Cache, appConfig.Cache.TTL)
 	}
 
-	c.Complexity.Post.Comments = countComplexityComment
-	c.Complexity.Comment.Replies = countComplexityReplice
+	resolver := graph.NewResolver(postMutation, postQuery, commentMutation, commentQuery, reactionMutation, reactionQuery, searchQuery, activityQuery,
+		render.NewRenderer(htmlCache))
+	c := graph.Config{Resolvers: resolver, Complexity: graph.NewComplexity(len(appConfig.Reactions.Allowed))}
+	res.Metrics.RegisterSubscriptions("commentAdded", resolver.Subscribers)
+	res.Metrics.RegisterSubscriptions("reactionChanged", resolver.ReactionSubscribers)
+	res.Metrics.RegisterSubscriptions("postAdded", resolver.PostSubscribers)
+
+	// every replica runs the scheduler, the storage publishes each post once
+	go scheduler.Run(ctx, postMutation, appConfig.Posts.PublishInterval, resolver.PostAdded)
 
 	srv := handler.New(graph.NewExecutableSchema(c))
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	srv.SetRecoverFunc(graph.Recover)
+
+	// websocket connections are hijacked, so http.Server.Shutdown doesn't wait for
+	// them; their contexts are canceled instead and gqlgen sends a close frame
+	wsCtx, closeWebsockets := context.WithCancel(context.Background())
+	defer closeWebsockets()
+	wsConns := newWSConnections()
 
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.Websocket{
-		KeepAlivePingInterval: 10 * time.Second,
+		KeepAlivePingInterval: cfg.KeepAlivePingInterval,
 		Upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
 				origin := r.Header.Get("Origin")
 				if origin == "" || origin == r.Header.Get("Host") {
 					return true
 				}
-				return slices.Contains([]string{"http://localhost:8080", "https://ozonhabr.com"}, origin)
+				return slices.Contains(cfg.AllowedOrigins, origin)
 			},
 		},
+		InitFunc: func(ctx context.Context, _ transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			ctx, err := wsConns.add(ctx)
+			if err != nil {
+				return ctx, nil, err
+			}
+			ctx, cancel := context.WithCancel(ctx)
+			context.AfterFunc(wsCtx, cancel)
+			return ctx, nil, nil
+		},
+		// called on every close path, also for the connections that didn't pass InitFunc
+		CloseFunc: func(ctx context.Context, closeCode int) {
+			wsConns.done(ctx)
+		},
 	})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.GRAPHQL{})
 
-	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
-	srv.Use(extension.Introspection{})
-	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New[string](100),
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](cfg.QueryCacheSize))
+	if cfg.Introspection {
+		srv.Use(extension.Introspection{})
+	}
+	err := usePersistedQueries(srv, appConfig, res)
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	srv.Use(querylimit.New(cfg.MaxDepth, cfg.ComplexityLimit))
+	srv.Use(res.Metrics.GraphQL())
+	srv.Use(tracing.GraphQL{})
+	srv.Use(accesslog.GraphQL{})
+	if appConfig.RateLimit.Enabled {
+		srv.Use(ratelimit.NewGraphQL(newLimiter(res), appConfig.RateLimit.Rules))
+	}
+	// every response gets its own loaders, so batches never mix requests
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		return next(postQuery.WithLoaders(commentQuery.WithLoaders(reactionQuery.WithLoaders(ctx))))
 	})
-	srv.Use(extension.FixedComplexityLimit(400)) // limit to +- 50 commments because there is not much space on web page
-	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
 
-	log.Info().Msgf("Connect to http://localhost:%s/ for GraphQL playground", port)
+	mux := http.NewServeMux()
+	// the playground can't work without introspection
+	if cfg.Introspection {
+		mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	}
+	mux.Handle("/query", tracing.Middleware(requestid.Middleware(
+		ratelimit.ClientIPMiddleware(appConfig.RateLimit.TrustProxy, viewer.Middleware(srv)),
+	)))
+	mux.Handle("/healthz", health.LivenessHandler())
+	mux.Handle("/readyz", health.ReadinessHandler(readinessChecks(appConfig, res), cfg.HealthTimeout))
+	mux.Handle("/metrics", res.Metrics.Handler())
+
+	httpServer := &http.Server{
+		Addr:         ":" + port,
+		Handler:      mux,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		if cfg.Introspection {
+			log.Info().Msgf("Connect to http://localhost:%s/ for GraphQL playground", port)
+		} else {
+			log.Info().Msgf("Serving GraphQL on http://localhost:%s/query", port)
+		}
+		serverErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("%s:failed to start server:%w", op, err)
+	case <-ctx.Done():
+	}
+
+	log.Info().Msg("Shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// subscribers get "complete" for their subscriptions before the connection is closed
+	resolver.Subscribers.CloseAll()
+	resolver.ReactionSubscribers.CloseAll()
+	resolver.PostSubscribers.CloseAll()
+	wsDrained := wsConns.stop()
+	closeWebsockets()
+
+	err = httpServer.Shutdown(shutdownCtx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Server didn't drain active requests in time")
+		err = httpServer.Close()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("%s:%w", op, err)
+		}
+	}
 
-	if err := http.ListenAndServe(":"+port, nil); err != nil {
-		log.Error().AnErr(op, err).Msg("Failed to start server")
-		os.Exit(1)
+	select {
+	case <-wsDrained:
+	case <-shutdownCtx.Done():
+		log.Warn().Msg("Websocket connections weren't closed in time")
 	}
 
-	log.Error().Msg("Unknown error")
+	log.Info().Msg("Server stopped")
+	return nil
+}
+
+// newLimiter shares the limits between replicas through redis when the
+// database storage is used.
+func newLimiter(res Resources) ratelimit.Limiter {
+	if res.Cache != nil {
+		return ratelimit.NewRedis(res.Cache.Ring)
+	}
+	return ratelimit.NewMemory()
+}
+
+// usePersistedQueries lets only the documents of the manifest run in the
+// trusted mode, otherwise any document runs and its hash is cached, in redis
+// when the database storage is used.
+func usePersistedQueries(srv *handler.Server, appConfig *config.Config, res Resources) error {
+	cfg := appConfig.PersistedQueries
+	if cfg.Mode == config.PersistedQueriesTrusted {
+		manifest, err := persisted.LoadManifest(cfg.Manifest)
+		if err != nil {
+			return err
+		}
+		log.Info().Msgf("Trusted documents mode, %d documents loaded", len(manifest))
+		srv.Use(persisted.NewTrusted(manifest))
+		return nil
+	}
+
+	var cache graphql.Cache[string] = lru.New[string](appConfig.Server.APQCacheSize)
+	if res.Cache != nil {
+		cache = persisted.NewRedisCache(res.Cache.Ring, cfg.CacheTTL)
+	}
+	srv.Use(extension.AutomaticPersistedQuery{Cache: cache})
+	return nil
 }
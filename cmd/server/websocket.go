@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var errShuttingDown = errors.New("server is shutting down")
+
+// wsConnections tracks the websocket connections that passed InitFunc, so the
+// shutdown can wait for them. gqlgen calls CloseFunc on every close path, also
+// for the connections that never got to InitFunc, so only the connections
+// marked in their context are released.
+type wsConnections struct {
+	mu       sync.Mutex
+	count    int
+	stopping bool
+	drained  chan struct{}
+}
+
+type wsTrackedKey struct{}
+
+func newWSConnections() *wsConnections {
+	return &wsConnections{drained: make(chan struct{})}
+}
+
+// add tracks the connection and marks its context, the connections are
+// refused once the shutdown has begun.
+func (c *wsConnections) add(ctx context.Context) (context.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopping {
+		return ctx, errShuttingDown
+	}
+	c.count++
+	return context.WithValue(ctx, wsTrackedKey{}, true), nil
+}
+
+// done releases the connection if its context was marked by add.
+func (c *wsConnections) done(ctx context.Context) {
+	if ctx.Value(wsTrackedKey{}) == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.count--
+	if c.stopping && c.count == 0 {
+		close(c.drained)
+	}
+}
+
+// stop refuses the new connections and returns the channel closed once the
+// tracked ones are closed.
+func (c *wsConnections) stop() <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.stopping {
+		c.stopping = true
+		if c.count == 0 {
+			close(c.drained)
+		}
+	}
+	return c.drained
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSConnections(t *testing.T) {
+	t.Run("Connections closed before InitFunc are ignored", func(t *testing.T) {
+		conns := newWSConnections()
+
+		ctx, err := conns.add(context.Background())
+		require.NoError(t, err)
+
+		// a connection closed on the init timeout or a decode error
+		conns.done(context.Background())
+		conns.done(context.Background())
+
+		drained := conns.stop()
+		select {
+		case <-drained:
+			t.Fatal("drained with a tracked connection open")
+		default:
+		}
+
+		conns.done(ctx)
+		<-drained
+	})
+
+	t.Run("Connections are refused after stop", func(t *testing.T) {
+		conns := newWSConnections()
+		<-conns.stop()
+
+		ctx, err := conns.add(context.Background())
+		assert.ErrorIs(t, err, errShuttingDown)
+
+		// CloseFunc still gets called for the refused connection
+		conns.done(ctx)
+		<-conns.stop()
+	})
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/graph/model"
+	internalmodel "github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+// postsListCost and tagsListCost are the estimated lengths of the posts and
+// tags lists, they aren't paginated.
+const (
+	postsListCost = 10
+	tagsListCost  = 20
+)
+
+// NewComplexity returns the cost of the list fields: a list costs its field
+// plus the cost of one element for every element it may return. reactions is
+// the number of allowed emoji, which bounds the reactions of a post or comment.
+func NewComplexity(reactions int) ComplexityRoot {
+	var c ComplexityRoot
+	reactionsCost := func(childComplexity int) int {
+		return 1 + max(reactions, 1)*childComplexity
+	}
+
+	c.Query.Posts = func(childComplexity int, orderBy *model.PostOrder, tag *string, filter *model.PostFilter) int {
+		return 1 + postsListCost*childComplexity
+	}
+	c.Query.Search = func(childComplexity int, query string, typeArg model.SearchType, first *int32, after *string) int {
+		return 1 + pageSize(first)*childComplexity
+	}
+	c.Query.Tags = func(childComplexity int) int {
+		return 1 + tagsListCost*childComplexity
+	}
+	c.Query.UserActivity = func(childComplexity int, authorID uuid.UUID, first *int32, after *string) int {
+		return 1 + pageSize(first)*childComplexity
+	}
+	c.Post.Comments = func(childComplexity int, first *int32, after *string, order *model.CommentOrder) int {
+		return 1 + pageSize(first)*childComplexity
+	}
+	c.Post.Tags = func(childComplexity int) int {
+		return 1 + internalmodel.MaxPostTags*childComplexity
+	}
+	c.Post.Reactions = reactionsCost
+	c.Comment.Replies = func(childComplexity int, first *int32, after *string, order *model.CommentOrder) int {
+		return 1 + pageSize(first)*childComplexity
+	}
+	c.Comment.Reactions = reactionsCost
+	c.ReactionEvent.Reactions = reactionsCost
+
+	return c
+}
+
+// pageSize is the page length of paginateInternalBranch, of the search and of
+// the activity feed.
+func pageSize(first *int32) int {
+	if first == nil {
+		return defaultFirst
+	}
+	return max(int(*first), 0)
+}
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nabishec/ozon_habr_api/graph/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestComplexity(t *testing.T) {
+	c := NewComplexity(4)
+	first := int32(10)
+
+	assert.Equal(t, 1+defaultFirst*3, c.Post.Comments(3, nil, nil, nil), "omitted first falls back to the default page")
+	assert.Equal(t, 31, c.Comment.Replies(3, &first, nil, nil))
+	assert.Equal(t, 1+postsListCost*2, c.Query.Posts(2, nil, nil, nil))
+	assert.Equal(t, 21, c.Query.Search(2, "go", model.SearchTypePost, &first, nil))
+	assert.Equal(t, 9, c.Post.Reactions(2))
+	assert.Equal(t, 1+tagsListCost, c.Query.Tags(1))
+}
+
+// TestComplexityListFields fails when a list field of the schema is left at
+// the default cost, which ignores how many elements it returns.
+func TestComplexityListFields(t *testing.T) {
+	root := reflect.ValueOf(NewComplexity(4))
+
+	for _, def := range parsedSchema.Types {
+		if def.Kind != ast.Object || def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		// the edges cost as many elements as the connection field asked for
+		if strings.HasSuffix(def.Name, "Connection") {
+			continue
+		}
+
+		for _, field := range def.Fields {
+			if field.Type.Elem == nil {
+				continue
+			}
+
+			complexity := root.FieldByName(def.Name)
+			if assert.True(t, complexity.IsValid(), "no complexity for %s", def.Name) {
+				f := complexity.FieldByName(strings.ToUpper(field.Name[:1]) + field.Name[1:])
+				assert.False(t, f.IsNil(), "%s.%s is a list without a cost", def.Name, field.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/requestid"
+	"github.com/rs/zerolog/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const internalErrorMessage = "internal server error"
+
+var errPanic = errors.New("panic")
+
+// ErrorPresenter reports every error with a stable extensions.code. Internal
+// errors are logged and replaced by a generic message, so their details don't
+// leak to clients; the request id lets the client refer to the log line.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	// errors built by gqlgen itself (parsing, validation, complexity) are meant
+	// for the client as they are
+	if gqlErr.Err == nil {
+		return gqlErr
+	}
+
+	// argument coercion fails before the field is resolved, such errors point
+	// below the field being resolved
+	if !isFieldError(ctx, gqlErr) && !errors.Is(gqlErr.Err, errPanic) {
+		if gqlErr.Extensions["code"] == nil {
+			setExtension(gqlErr, "code", errs.CodeValidation)
+		}
+		return gqlErr
+	}
+
+	code := errs.Code(gqlErr.Err)
+	if code == errs.CodeInternal {
+		id := requestid.FromContext(ctx)
+		log.Ctx(ctx).Error().Err(gqlErr.Err).Str("path", gqlErr.Path.String()).Msg("internal error")
+
+		gqlErr.Message = internalErrorMessage
+		if id != "" {
+			setExtension(gqlErr, "requestId", id)
+		}
+	}
+	setExtension(gqlErr, "code", code)
+
+	var rateLimitErr *errs.RateLimitError
+	if errors.As(gqlErr.Err, &rateLimitErr) {
+		// whole seconds, like the Retry-After header
+		setExtension(gqlErr, "retryAfter", int(math.Ceil(rateLimitErr.RetryAfter.Seconds())))
+	}
+
+	var validationErr *errs.ValidationError
+	if errors.As(gqlErr.Err, &validationErr) {
+		fields := make([]map[string]string, len(validationErr.Fields))
+		for i, field := range validationErr.Fields {
+			fields[i] = map[string]string{"field": field.Field, "message": field.Message}
+		}
+		setExtension(gqlErr, "fields", fields)
+	}
+
+	return gqlErr
+}
+
+func isFieldError(ctx context.Context, gqlErr *gqlerror.Error) bool {
+	fc := graphql.GetFieldContext(ctx)
+	return fc != nil && fc.Path().String() == gqlErr.Path.String()
+}
+
+// Recover turns a resolver panic into an internal error.
+func Recover(ctx context.Context, p any) error {
+	log.Ctx(ctx).Error().Str("stack", string(debug.Stack())).Msgf("resolver panic: %v", p)
+	return fmt.Errorf("%w: %v", errPanic, p)
+}
+
+func setExtension(gqlErr *gqlerror.Error, key string, value any) {
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]any{}
+	}
+	gqlErr.Extensions[key] = value
+}
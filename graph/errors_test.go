@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/requestid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// fieldContext returns the context of the resolver of the root field.
+func fieldContext(field string) context.Context {
+	return graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: &ast.Field{Name: field, Alias: field}},
+	})
+}
+
+func TestErrorPresenter(t *testing.T) {
+	t.Run("Validation error with fields", func(t *testing.T) {
+		err := &errs.ValidationError{Fields: []errs.FieldError{
+			{Field: "title", Message: "must not be empty"},
+			{Field: "tags[1]", Message: "must not be empty"},
+		}}
+
+		gqlErr := ErrorPresenter(fieldContext("addPost"), fmt.Errorf("internal.handlers.AddPost():%w", err))
+		assert.Equal(t, errs.CodeValidation, gqlErr.Extensions["code"])
+		assert.Equal(t, []map[string]string{
+			{"field": "title", "message": "must not be empty"},
+			{"field": "tags[1]", "message": "must not be empty"},
+		}, gqlErr.Extensions["fields"])
+		assert.Equal(t, ast.Path{ast.PathName("addPost")}, gqlErr.Path)
+	})
+
+	t.Run("Validation error without fields", func(t *testing.T) {
+		gqlErr := ErrorPresenter(fieldContext("posts"), errs.ErrInvalidPostFilter)
+		assert.Equal(t, errs.CodeValidation, gqlErr.Extensions["code"])
+		assert.NotContains(t, gqlErr.Extensions, "fields")
+		assert.Equal(t, errs.ErrInvalidPostFilter.Error(), gqlErr.Message)
+	})
+
+	t.Run("Rate limited with retry after", func(t *testing.T) {
+		gqlErr := ErrorPresenter(fieldContext("addPost"), &errs.RateLimitError{RetryAfter: 1500 * time.Millisecond})
+		assert.Equal(t, errs.CodeRateLimited, gqlErr.Extensions["code"])
+		assert.Equal(t, 2, gqlErr.Extensions["retryAfter"], "rounded up to whole seconds")
+	})
+
+	t.Run("Internal error is hidden", func(t *testing.T) {
+		ctx := requestid.NewContext(fieldContext("posts"), "request-1")
+
+		gqlErr := ErrorPresenter(ctx, errors.New("connection refused"))
+		assert.Equal(t, errs.CodeInternal, gqlErr.Extensions["code"])
+		assert.Equal(t, internalErrorMessage, gqlErr.Message)
+		assert.Equal(t, "request-1", gqlErr.Extensions["requestId"])
+	})
+
+	t.Run("Argument error below the field", func(t *testing.T) {
+		ctx := fieldContext("addPost")
+		err := graphql.ErrorOnPath(graphql.WithPathContext(ctx, graphql.NewPathWithField("postInput")), errors.New("invalid UUID"))
+
+		gqlErr := ErrorPresenter(ctx, err)
+		assert.Equal(t, errs.CodeValidation, gqlErr.Extensions["code"])
+		assert.Equal(t, "invalid UUID", gqlErr.Message)
+	})
+}
@@ -3,21 +3,59 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type ActivityItem interface {
+	IsActivityItem()
+}
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type ActivityConnection struct {
+	Edges    []*ActivityEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type ActivityEdge struct {
+	Node   ActivityItem `json:"node"`
+	Cursor string       `json:"cursor"`
+	// The title of the post, or of the post the comment belongs to.
+	PostTitle string `json:"postTitle"`
+}
+
 type Comment struct {
-	ID         int64              `json:"id"`
-	AuthorID   uuid.UUID          `json:"authorID"`
-	PostID     int64              `json:"postID"`
-	ParentID   *int64             `json:"parentID,omitempty"`
-	Text       string             `json:"text"`
-	CreateDate time.Time          `json:"createDate"`
-	Replies    *CommentConnection `json:"replies,omitempty"`
+	ID               int64              `json:"id"`
+	AuthorID         uuid.UUID          `json:"authorID"`
+	PostID           int64              `json:"postID"`
+	ParentID         *int64             `json:"parentID,omitempty"`
+	Text             string             `json:"text"`
+	CreateDate       time.Time          `json:"createDate"`
+	Replies          *CommentConnection `json:"replies,omitempty"`
+	RepliesCount     int32              `json:"repliesCount"`
+	DescendantsCount int32              `json:"descendantsCount"`
+	// Nesting level, the root comments are at level 1.
+	Level int32 `json:"level"`
+	Score int32 `json:"score"`
+	// The vote of the user from the X-User-ID header, null for anonymous requests.
+	MyVote    *VoteValue  `json:"myVote,omitempty"`
+	Reactions []*Reaction `json:"reactions"`
+	Format    TextFormat  `json:"format"`
+	// The text rendered to sanitized HTML.
+	HTML string `json:"html"`
 }
 
+func (Comment) IsSearchResult() {}
+
+func (Comment) IsActivityItem() {}
+
 type CommentConnection struct {
 	Edges    []*CommentEdge `json:"edges"`
 	PageInfo *PageInfo      `json:"pageInfo"`
@@ -32,10 +70,11 @@ type Mutation struct {
 }
 
 type NewComment struct {
-	AuthorID uuid.UUID `json:"authorID"`
-	PostID   int64     `json:"postID"`
-	ParentID *int64    `json:"parentID,omitempty"`
-	Text     string    `json:"text"`
+	AuthorID uuid.UUID   `json:"authorID"`
+	PostID   int64       `json:"postID"`
+	ParentID *int64      `json:"parentID,omitempty"`
+	Text     string      `json:"text"`
+	Format   *TextFormat `json:"format,omitempty"`
 }
 
 type NewPost struct {
@@ -43,6 +82,15 @@ type NewPost struct {
 	Title           string    `json:"title"`
 	Text            string    `json:"text"`
 	CommentsEnabled bool      `json:"commentsEnabled"`
+	// Tags are lowercased, trimmed and deduplicated, at most 10 tags of up to 32 characters.
+	Tags   []string    `json:"tags,omitempty"`
+	Status *PostStatus `json:"status,omitempty"`
+	// Required for scheduled posts only, must be in the future.
+	PublishAt *time.Time  `json:"publishAt,omitempty"`
+	Format    *TextFormat `json:"format,omitempty"`
+	// Overrides the global nesting limit of the comments, 0 disables it.
+	MaxCommentDepth  *int32            `json:"maxCommentDepth,omitempty"`
+	CommentDepthMode *CommentDepthMode `json:"commentDepthMode,omitempty"`
 }
 
 type PageInfo struct {
@@ -58,10 +106,459 @@ type Post struct {
 	CommentsEnabled bool               `json:"commentsEnabled"`
 	Comments        *CommentConnection `json:"comments,omitempty"`
 	CreateDate      time.Time          `json:"createDate"`
+	CommentsCount   int32              `json:"commentsCount"`
+	LastCommentAt   *time.Time         `json:"lastCommentAt,omitempty"`
+	Score           int32              `json:"score"`
+	Upvotes         int32              `json:"upvotes"`
+	Downvotes       int32              `json:"downvotes"`
+	// The vote of the user from the X-User-ID header, null for anonymous requests.
+	MyVote    *VoteValue  `json:"myVote,omitempty"`
+	Reactions []*Reaction `json:"reactions"`
+	// Normalized tags of the post in alphabetical order.
+	Tags []string `json:"tags"`
+	// Drafts and scheduled posts are visible only to their author.
+	Status PostStatus `json:"status"`
+	// When the post was or is going to be published, null for drafts.
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	Format    TextFormat `json:"format"`
+	// The text rendered to sanitized HTML.
+	HTML string `json:"html"`
+	// The nesting limit of the comments, null means the global one, 0 no limit.
+	MaxCommentDepth *int32 `json:"maxCommentDepth,omitempty"`
+	// What happens to the replies deeper than the limit, null means the global setting.
+	CommentDepthMode *CommentDepthMode `json:"commentDepthMode,omitempty"`
+}
+
+func (Post) IsSearchResult() {}
+
+func (Post) IsActivityItem() {}
+
+// Selects the posts matching all of the given fields, the dates are exclusive.
+type PostFilter struct {
+	AuthorID        *uuid.UUID `json:"authorID,omitempty"`
+	CreatedAfter    *time.Time `json:"createdAfter,omitempty"`
+	CreatedBefore   *time.Time `json:"createdBefore,omitempty"`
+	CommentsEnabled *bool      `json:"commentsEnabled,omitempty"`
+}
+
+type PostOrder struct {
+	Field     PostOrderField `json:"field"`
+	Direction OrderDirection `json:"direction"`
 }
 
 type Query struct {
 }
 
+type Reaction struct {
+	Emoji string `json:"emoji"`
+	Count int32  `json:"count"`
+	// Whether the user from the X-User-ID header reacted with the emoji.
+	ReactedByMe bool `json:"reactedByMe"`
+}
+
+type ReactionEvent struct {
+	PostID    int64          `json:"postID"`
+	Target    ReactionTarget `json:"target"`
+	TargetID  int64          `json:"targetID"`
+	Reactions []*Reaction    `json:"reactions"`
+}
+
+type SearchConnection struct {
+	Edges    []*SearchEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type SearchEdge struct {
+	Node   SearchResult `json:"node"`
+	Cursor string       `json:"cursor"`
+	Rank   float64      `json:"rank"`
+	// A fragment of the content with the matches wrapped in <b>, the rest of it is HTML-escaped.
+	Snippet string `json:"snippet"`
+}
+
 type Subscription struct {
 }
+
+type Tag struct {
+	Name       string `json:"name"`
+	PostsCount int32  `json:"postsCount"`
+}
+
+type CommentDepthMode string
+
+const (
+	// Deeper replies fail with the VALIDATION code.
+	CommentDepthModeReject CommentDepthMode = "REJECT"
+	// Deeper replies are attached to the deepest allowed ancestor of their parent.
+	CommentDepthModeFlatten CommentDepthMode = "FLATTEN"
+)
+
+var AllCommentDepthMode = []CommentDepthMode{
+	CommentDepthModeReject,
+	CommentDepthModeFlatten,
+}
+
+func (e CommentDepthMode) IsValid() bool {
+	switch e {
+	case CommentDepthModeReject, CommentDepthModeFlatten:
+		return true
+	}
+	return false
+}
+
+func (e CommentDepthMode) String() string {
+	return string(e)
+}
+
+func (e *CommentDepthMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentDepthMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentDepthMode", str)
+	}
+	return nil
+}
+
+func (e CommentDepthMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CommentOrder string
+
+const (
+	CommentOrderOldest CommentOrder = "OLDEST"
+	CommentOrderTop    CommentOrder = "TOP"
+)
+
+var AllCommentOrder = []CommentOrder{
+	CommentOrderOldest,
+	CommentOrderTop,
+}
+
+func (e CommentOrder) IsValid() bool {
+	switch e {
+	case CommentOrderOldest, CommentOrderTop:
+		return true
+	}
+	return false
+}
+
+func (e CommentOrder) String() string {
+	return string(e)
+}
+
+func (e *CommentOrder) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentOrder", str)
+	}
+	return nil
+}
+
+func (e CommentOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostOrderField string
+
+const (
+	PostOrderFieldCreateDate    PostOrderField = "CREATE_DATE"
+	PostOrderFieldCommentsCount PostOrderField = "COMMENTS_COUNT"
+	PostOrderFieldLastCommentAt PostOrderField = "LAST_COMMENT_AT"
+	PostOrderFieldScore         PostOrderField = "SCORE"
+)
+
+var AllPostOrderField = []PostOrderField{
+	PostOrderFieldCreateDate,
+	PostOrderFieldCommentsCount,
+	PostOrderFieldLastCommentAt,
+	PostOrderFieldScore,
+}
+
+func (e PostOrderField) IsValid() bool {
+	switch e {
+	case PostOrderFieldCreateDate, PostOrderFieldCommentsCount, PostOrderFieldLastCommentAt, PostOrderFieldScore:
+		return true
+	}
+	return false
+}
+
+func (e PostOrderField) String() string {
+	return string(e)
+}
+
+func (e *PostOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostOrderField", str)
+	}
+	return nil
+}
+
+func (e PostOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "DRAFT"
+	PostStatusScheduled PostStatus = "SCHEDULED"
+	PostStatusPublished PostStatus = "PUBLISHED"
+)
+
+var AllPostStatus = []PostStatus{
+	PostStatusDraft,
+	PostStatusScheduled,
+	PostStatusPublished,
+}
+
+func (e PostStatus) IsValid() bool {
+	switch e {
+	case PostStatusDraft, PostStatusScheduled, PostStatusPublished:
+		return true
+	}
+	return false
+}
+
+func (e PostStatus) String() string {
+	return string(e)
+}
+
+func (e *PostStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostStatus", str)
+	}
+	return nil
+}
+
+func (e PostStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ReactionTarget string
+
+const (
+	ReactionTargetPost    ReactionTarget = "POST"
+	ReactionTargetComment ReactionTarget = "COMMENT"
+)
+
+var AllReactionTarget = []ReactionTarget{
+	ReactionTargetPost,
+	ReactionTargetComment,
+}
+
+func (e ReactionTarget) IsValid() bool {
+	switch e {
+	case ReactionTargetPost, ReactionTargetComment:
+		return true
+	}
+	return false
+}
+
+func (e ReactionTarget) String() string {
+	return string(e)
+}
+
+func (e *ReactionTarget) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReactionTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReactionTarget", str)
+	}
+	return nil
+}
+
+func (e ReactionTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchType string
+
+const (
+	SearchTypePost    SearchType = "POST"
+	SearchTypeComment SearchType = "COMMENT"
+)
+
+var AllSearchType = []SearchType{
+	SearchTypePost,
+	SearchTypeComment,
+}
+
+func (e SearchType) IsValid() bool {
+	switch e {
+	case SearchTypePost, SearchTypeComment:
+		return true
+	}
+	return false
+}
+
+func (e SearchType) String() string {
+	return string(e)
+}
+
+func (e *SearchType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchType", str)
+	}
+	return nil
+}
+
+func (e SearchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TextFormat string
+
+const (
+	TextFormatPlain    TextFormat = "PLAIN"
+	TextFormatMarkdown TextFormat = "MARKDOWN"
+)
+
+var AllTextFormat = []TextFormat{
+	TextFormatPlain,
+	TextFormatMarkdown,
+}
+
+func (e TextFormat) IsValid() bool {
+	switch e {
+	case TextFormatPlain, TextFormatMarkdown:
+		return true
+	}
+	return false
+}
+
+func (e TextFormat) String() string {
+	return string(e)
+}
+
+func (e *TextFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TextFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TextFormat", str)
+	}
+	return nil
+}
+
+func (e TextFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type VoteValue string
+
+const (
+	VoteValueUp   VoteValue = "UP"
+	VoteValueDown VoteValue = "DOWN"
+	VoteValueNone VoteValue = "NONE"
+)
+
+var AllVoteValue = []VoteValue{
+	VoteValueUp,
+	VoteValueDown,
+	VoteValueNone,
+}
+
+func (e VoteValue) IsValid() bool {
+	switch e {
+	case VoteValueUp, VoteValueDown, VoteValueNone:
+		return true
+	}
+	return false
+}
+
+func (e VoteValue) String() string {
+	return string(e)
+}
+
+func (e *VoteValue) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VoteValue(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VoteValue", str)
+	}
+	return nil
+}
+
+func (e VoteValue) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -1,10 +1,18 @@
 package graph
 
 import (
+	activityquery "github.com/nabishec/ozon_habr_api/internal/handlers/activity_query"
 	commentmutation "github.com/nabishec/ozon_habr_api/internal/handlers/comment_mutation"
 	commentquery "github.com/nabishec/ozon_habr_api/internal/handlers/comment_query"
 	postmutation "github.com/nabishec/ozon_habr_api/internal/handlers/post_mutation"
 	postquery "github.com/nabishec/ozon_habr_api/internal/handlers/post_query"
+	reactionmutation "github.com/nabishec/ozon_habr_api/internal/handlers/reaction_mutation"
+	reactionquery "github.com/nabishec/ozon_habr_api/internal/handlers/reaction_query"
+	searchquery "github.com/nabishec/ozon_habr_api/internal/handlers/search_query"
+	"github.com/nabishec/ozon_habr_api/internal/render"
+
+	"github.com/nabishec/ozon_habr_api/graph/model"
+	internalmodel "github.com/nabishec/ozon_habr_api/internal/model"
 )
 
 // This file will not be regenerated automatically.
@@ -12,19 +20,43 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	PostMutation    *postmutation.PostMutation
-	PostQuery       *postquery.PostQuery
-	CommentMutation *commentmutation.CommentMutation
-	CommentQuery    *commentquery.CommentQuery
-	Subscribers     *Subscribers
+	PostMutation        *postmutation.PostMutation
+	PostQuery           *postquery.PostQuery
+	CommentMutation     *commentmutation.CommentMutation
+	CommentQuery        *commentquery.CommentQuery
+	ReactionMutation    *reactionmutation.ReactionMutation
+	ReactionQuery       *reactionquery.ReactionQuery
+	SearchQuery         *searchquery.SearchQuery
+	ActivityQuery       *activityquery.ActivityQuery
+	Subscribers         *Subscribers[*model.Comment]
+	ReactionSubscribers *Subscribers[*model.ReactionEvent]
+	PostSubscribers     *Subscribers[*model.Post]
+	Renderer            *render.Renderer
 }
 
-func NewResolver(postMutation *postmutation.PostMutation, postQuery *postquery.PostQuery, commentMutation *commentmutation.CommentMutation, commentQuery *commentquery.CommentQuery) *Resolver {
+// allPosts is the key of the postAdded subscribers, they aren't bound to a post.
+const allPosts int64 = 0
+
+func NewResolver(postMutation *postmutation.PostMutation, postQuery *postquery.PostQuery, commentMutation *commentmutation.CommentMutation, commentQuery *commentquery.CommentQuery,
+	reactionMutation *reactionmutation.ReactionMutation, reactionQuery *reactionquery.ReactionQuery, searchQuery *searchquery.SearchQuery, activityQuery *activityquery.ActivityQuery,
+	renderer *render.Renderer) *Resolver {
 	return &Resolver{
-		PostMutation:    postMutation,
-		PostQuery:       postQuery,
-		CommentMutation: commentMutation,
-		CommentQuery:    commentQuery,
-		Subscribers:     NewSubscribers(),
+		PostMutation:        postMutation,
+		PostQuery:           postQuery,
+		CommentMutation:     commentMutation,
+		CommentQuery:        commentQuery,
+		ReactionMutation:    reactionMutation,
+		ReactionQuery:       reactionQuery,
+		SearchQuery:         searchQuery,
+		ActivityQuery:       activityQuery,
+		Subscribers:         NewSubscribers[*model.Comment](),
+		ReactionSubscribers: NewSubscribers[*model.ReactionEvent](),
+		PostSubscribers:     NewSubscribers[*model.Post](),
+		Renderer:            renderer,
 	}
 }
+
+// PostAdded delivers the just published post to the postAdded subscribers.
+func (r *Resolver) PostAdded(post *internalmodel.Post) {
+	r.PostSubscribers.Pub(allPosts, postFromInternalModel(post))
+}
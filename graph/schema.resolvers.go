@@ -7,6 +7,7 @@ package graph
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/graph/model"
@@ -17,28 +18,28 @@ import (
 )
 
 // Replies is the resolver for the replies field.
-func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment, first *int32, after *string) (*model.CommentConnection, error) {
+func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment, first *int32, after *string, order *model.CommentOrder) (*model.CommentConnection, error) {
 	const op = "graph.Replies()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	var path string
 	var err error
 	if after != nil {
 		err = cursor.ValidateAfter(after)
 		if err != nil {
-			return nil, errors.New("invalid after")
+			return nil, errs.ErrInvalidAfterCursor
 		}
 
 		path, err = cursor.GetPath(after)
 		if err != nil {
-			return nil, errors.New("invalid after")
+			return nil, errs.ErrInvalidAfterCursor
 		}
 	} else {
 		path, err = r.CommentQuery.GetPathToComments(ctx, obj.ID)
 		if err != nil {
-			if err != errs.ErrCommentsNotExist {
-				err = errors.New("internal server error")
+			if !errors.Is(err, errs.ErrCommentsNotExist) {
+				return nil, err
 			}
 
 			comments := &model.CommentConnection{
@@ -50,13 +51,13 @@ func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment, first
 
 	}
 
-	internalCommentsBranch, err := r.CommentQuery.GetCommentsBranchToPost(ctx, obj.PostID, path)
+	internalCommentsBranch, err := r.CommentQuery.GetCommentsBranchToPost(ctx, obj.PostID, path, commentOrderToInternalModel(order))
 	if err != nil {
-		if err != errs.ErrCommentsNotExist {
-			if err == errs.ErrPathNotExist {
+		if !errors.Is(err, errs.ErrCommentsNotExist) {
+			if errors.Is(err, errs.ErrPathNotExist) {
 				return nil, nil // it works when there are no replies to the comment.
 			}
-			err = errors.New("internal server error")
+			return nil, err
 		}
 		comments := &model.CommentConnection{
 			Edges:    []*model.CommentEdge{},
@@ -67,69 +68,222 @@ func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment, first
 	}
 
 	commentBranch, err := paginateInternalBranch(internalCommentsBranch, first, after)
+	if err != nil {
+		return nil, err
+	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
 	return commentBranch, nil
 }
 
+// MyVote is the resolver for the myVote field.
+func (r *commentResolver) MyVote(ctx context.Context, obj *model.Comment) (*model.VoteValue, error) {
+	const op = "graph.Comment.MyVote()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	vote, ok, err := r.CommentQuery.GetMyVote(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	value := voteFromInternalModel(vote)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return &value, nil
+}
+
+// Reactions is the resolver for the reactions field.
+func (r *commentResolver) Reactions(ctx context.Context, obj *model.Comment) ([]*model.Reaction, error) {
+	return r.reactions(ctx, internalmodel.ReactionKey{Target: internalmodel.ReactionTargetComment, ID: obj.ID})
+}
+
+// HTML is the resolver for the html field.
+func (r *commentResolver) HTML(ctx context.Context, obj *model.Comment) (string, error) {
+	return r.Renderer.HTML(ctx, textFormatToInternalModel(&obj.Format), obj.Text), nil
+}
+
 // AddPost is the resolver for the addPost field.
 func (r *mutationResolver) AddPost(ctx context.Context, postInput model.NewPost) (*model.Post, error) {
 	const op = "graph.AddPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	newPost := newPostToInternalModel(&postInput)
 	post, err := r.PostMutation.AddPost(ctx, newPost)
 
 	if err != nil {
-		log.Error().Err(err).Msgf("%s end with error", op)
+		return nil, err
+	}
 
-		return nil, errors.New("internal server error")
+	if post.Status == internalmodel.PostPublished {
+		r.PostAdded(post)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return postFromInternalModel(post), err
 }
 
 func newPostToInternalModel(post *model.NewPost) *internalmodel.NewPost {
 	return &internalmodel.NewPost{
-		AuthorID:        post.AuthorID,
-		Title:           post.Title,
-		Text:            post.Text,
-		CommentsEnabled: post.CommentsEnabled,
+		AuthorID:         post.AuthorID,
+		Title:            post.Title,
+		Text:             post.Text,
+		CommentsEnabled:  post.CommentsEnabled,
+		Tags:             post.Tags,
+		Status:           postStatusToInternalModel(post.Status),
+		PublishAt:        post.PublishAt,
+		Format:           textFormatToInternalModel(post.Format),
+		MaxCommentDepth:  maxCommentDepthToInternalModel(post.MaxCommentDepth),
+		CommentDepthMode: commentDepthModeToInternalModel(post.CommentDepthMode),
+	}
+}
+
+func maxCommentDepthToInternalModel(depth *int32) *int {
+	if depth == nil {
+		return nil
+	}
+	value := int(*depth)
+	return &value
+}
+
+func maxCommentDepthFromInternalModel(depth *int) *int32 {
+	if depth == nil {
+		return nil
+	}
+	value := int32(*depth)
+	return &value
+}
+
+func commentDepthModeToInternalModel(mode *model.CommentDepthMode) *internalmodel.DepthMode {
+	if mode == nil {
+		return nil
+	}
+	value := internalmodel.DepthReject
+	if *mode == model.CommentDepthModeFlatten {
+		value = internalmodel.DepthFlatten
 	}
+	return &value
+}
+
+func commentDepthModeFromInternalModel(mode *internalmodel.DepthMode) *model.CommentDepthMode {
+	if mode == nil {
+		return nil
+	}
+	value := model.CommentDepthModeReject
+	if *mode == internalmodel.DepthFlatten {
+		value = model.CommentDepthModeFlatten
+	}
+	return &value
+}
+
+func textFormatToInternalModel(format *model.TextFormat) internalmodel.TextFormat {
+	if format != nil && *format == model.TextFormatMarkdown {
+		return internalmodel.FormatMarkdown
+	}
+	return internalmodel.FormatPlain
+}
+
+func textFormatFromInternalModel(format internalmodel.TextFormat) model.TextFormat {
+	if format == internalmodel.FormatMarkdown {
+		return model.TextFormatMarkdown
+	}
+	return model.TextFormatPlain
+}
+
+func postStatusToInternalModel(status *model.PostStatus) internalmodel.PostStatus {
+	if status == nil {
+		return internalmodel.PostPublished
+	}
+	switch *status {
+	case model.PostStatusDraft:
+		return internalmodel.PostDraft
+	case model.PostStatusScheduled:
+		return internalmodel.PostScheduled
+	}
+	return internalmodel.PostPublished
+}
+
+func postStatusFromInternalModel(status internalmodel.PostStatus) model.PostStatus {
+	switch status {
+	case internalmodel.PostDraft:
+		return model.PostStatusDraft
+	case internalmodel.PostScheduled:
+		return model.PostStatusScheduled
+	}
+	return model.PostStatusPublished
 }
 
 func postFromInternalModel(internalPost *internalmodel.Post) *model.Post {
 	return &model.Post{
-		ID:              internalPost.ID,
-		AuthorID:        internalPost.AuthorID,
-		Title:           internalPost.Title,
-		Text:            internalPost.Text,
-		CommentsEnabled: internalPost.CommentsEnabled,
-		CreateDate:      internalPost.CreateDate,
+		ID:               internalPost.ID,
+		AuthorID:         internalPost.AuthorID,
+		Title:            internalPost.Title,
+		Text:             internalPost.Text,
+		CommentsEnabled:  internalPost.CommentsEnabled,
+		CreateDate:       internalPost.CreateDate,
+		CommentsCount:    int32(internalPost.CommentsCount),
+		LastCommentAt:    internalPost.LastCommentAt,
+		Score:            int32(internalPost.Score),
+		Upvotes:          int32(internalPost.Upvotes),
+		Downvotes:        int32(internalPost.Downvotes),
+		Status:           postStatusFromInternalModel(internalPost.Status),
+		PublishAt:        internalPost.PublishAt,
+		Format:           textFormatFromInternalModel(internalPost.Format),
+		MaxCommentDepth:  maxCommentDepthFromInternalModel(internalPost.MaxCommentDepth),
+		CommentDepthMode: commentDepthModeFromInternalModel(internalPost.CommentDepthMode),
 	}
 }
 
+func postsOptionsToInternalModel(orderBy *model.PostOrder, tag *string, filter *model.PostFilter) internalmodel.PostsOptions {
+	var opts internalmodel.PostsOptions
+	if tag != nil {
+		opts.Tag = *tag
+	}
+	if filter != nil {
+		opts.Filter = internalmodel.PostFilter{
+			AuthorID:        filter.AuthorID,
+			CreatedAfter:    filter.CreatedAfter,
+			CreatedBefore:   filter.CreatedBefore,
+			CommentsEnabled: filter.CommentsEnabled,
+		}
+	}
+	if orderBy == nil {
+		return opts
+	}
+
+	switch orderBy.Field {
+	case model.PostOrderFieldCreateDate:
+		opts.OrderBy = internalmodel.PostOrderCreateDate
+	case model.PostOrderFieldCommentsCount:
+		opts.OrderBy = internalmodel.PostOrderCommentsCount
+	case model.PostOrderFieldLastCommentAt:
+		opts.OrderBy = internalmodel.PostOrderLastCommentAt
+	case model.PostOrderFieldScore:
+		opts.OrderBy = internalmodel.PostOrderScore
+	}
+	opts.Desc = orderBy.Direction == model.OrderDirectionDesc
+	return opts
+}
+
 // AddComment is the resolver for the addComment field.
 func (r *mutationResolver) AddComment(ctx context.Context, commentInput model.NewComment) (*model.Comment, error) {
 	const op = "graph.AddComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	newInternalComment := newCommentToInternalModel(&commentInput)
 	internalComment, err := r.CommentMutation.AddComment(ctx, newInternalComment.PostID, newInternalComment)
 	if err != nil {
-		if err != errs.ErrPostNotExist && err != errs.ErrParentCommentNotExist && err != errs.ErrCommentsNotEnabled {
-			err = errors.New("internal server error")
-		}
 		return nil, err
 	}
 
 	comment := commentFromInternalModel(internalComment)
 	r.Subscribers.Pub(commentInput.PostID, comment)
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return comment, nil
 }
 
@@ -139,6 +293,7 @@ func newCommentToInternalModel(newComment *model.NewComment) *internalmodel.NewC
 		PostID:   newComment.PostID,
 		ParentID: newComment.ParentID,
 		Text:     newComment.Text,
+		Format:   textFormatToInternalModel(newComment.Format),
 	}
 }
 
@@ -146,27 +301,177 @@ func newCommentToInternalModel(newComment *model.NewComment) *internalmodel.NewC
 func (r *mutationResolver) UpdateEnableComment(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
 	const op = "graph.UpdateEnableComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	post, err := r.PostMutation.UpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
 
 	if err != nil {
-		log.Error().Err(err).Msgf("%s end with error", op)
-		if err != errs.ErrPostNotExist && err != errs.ErrUnauthorizedAccess {
-			err = errors.New("internal server error")
-		}
 		return nil, err
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postFromInternalModel(post), err
+}
+
+// PublishPost is the resolver for the publishPost field.
+func (r *mutationResolver) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	const op = "graph.PublishPost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.PostMutation.PublishPost(ctx, postID, authorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.PostAdded(post)
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postFromInternalModel(post), err
+}
+
+// SchedulePost is the resolver for the schedulePost field.
+func (r *mutationResolver) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	const op = "graph.SchedulePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.PostMutation.SchedulePost(ctx, postID, authorID, publishAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return postFromInternalModel(post), err
 }
 
+// VotePost is the resolver for the votePost field.
+func (r *mutationResolver) VotePost(ctx context.Context, postID int64, authorID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	const op = "graph.VotePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.PostMutation.VotePost(ctx, postID, authorID, voteToInternalModel(value))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postFromInternalModel(post), nil
+}
+
+// VoteComment is the resolver for the voteComment field.
+func (r *mutationResolver) VoteComment(ctx context.Context, commentID int64, authorID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	const op = "graph.VoteComment()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	comment, err := r.CommentMutation.VoteComment(ctx, commentID, authorID, voteToInternalModel(value))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return commentFromInternalModel(comment), nil
+}
+
+func voteToInternalModel(value model.VoteValue) internalmodel.VoteValue {
+	switch value {
+	case model.VoteValueUp:
+		return internalmodel.VoteUp
+	case model.VoteValueDown:
+		return internalmodel.VoteDown
+	}
+	return internalmodel.VoteNone
+}
+
+func voteFromInternalModel(value internalmodel.VoteValue) model.VoteValue {
+	switch value {
+	case internalmodel.VoteUp:
+		return model.VoteValueUp
+	case internalmodel.VoteDown:
+		return model.VoteValueDown
+	}
+	return model.VoteValueNone
+}
+
+// AddReaction is the resolver for the addReaction field.
+func (r *mutationResolver) AddReaction(ctx context.Context, target model.ReactionTarget, targetID int64, authorID uuid.UUID, emoji string) (*model.ReactionEvent, error) {
+	const op = "graph.AddReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	postID, changed, err := r.ReactionMutation.AddReaction(ctx, reactionKeyToInternalModel(target, targetID), authorID, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &model.ReactionEvent{PostID: postID, Target: target, TargetID: targetID}
+	if changed {
+		r.ReactionSubscribers.Pub(postID, event)
+	}
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return event, nil
+}
+
+// RemoveReaction is the resolver for the removeReaction field.
+func (r *mutationResolver) RemoveReaction(ctx context.Context, target model.ReactionTarget, targetID int64, authorID uuid.UUID, emoji string) (*model.ReactionEvent, error) {
+	const op = "graph.RemoveReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	postID, changed, err := r.ReactionMutation.RemoveReaction(ctx, reactionKeyToInternalModel(target, targetID), authorID, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &model.ReactionEvent{PostID: postID, Target: target, TargetID: targetID}
+	if changed {
+		r.ReactionSubscribers.Pub(postID, event)
+	}
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return event, nil
+}
+
+func reactionKeyToInternalModel(target model.ReactionTarget, targetID int64) internalmodel.ReactionKey {
+	if target == model.ReactionTargetComment {
+		return internalmodel.ReactionKey{Target: internalmodel.ReactionTargetComment, ID: targetID}
+	}
+	return internalmodel.ReactionKey{Target: internalmodel.ReactionTargetPost, ID: targetID}
+}
+
+// reactions resolves the reactions field of posts, comments and reaction
+// events, ReactedByMe depends on the viewer of the request, so the reactions
+// of an event are loaded for every subscriber separately.
+func (r *Resolver) reactions(ctx context.Context, key internalmodel.ReactionKey) ([]*model.Reaction, error) {
+	const op = "graph.Reactions()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	internalReactions, err := r.ReactionQuery.GetReactions(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions := make([]*model.Reaction, len(internalReactions))
+	for i, v := range internalReactions {
+		reactions[i] = &model.Reaction{
+			Emoji:       v.Emoji,
+			Count:       int32(v.Count),
+			ReactedByMe: v.ReactedByMe,
+		}
+	}
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return reactions, nil
+}
+
 // Comments is the resolver for the comments field.
-func (r *postResolver) Comments(ctx context.Context, obj *model.Post, first *int32, after *string) (*model.CommentConnection, error) {
+func (r *postResolver) Comments(ctx context.Context, obj *model.Post, first *int32, after *string, order *model.CommentOrder) (*model.CommentConnection, error) {
 	const op = "graph.Comments()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 	if obj.CommentsEnabled == false {
 		obj.Comments = &model.CommentConnection{
 			Edges:    []*model.CommentEdge{},
@@ -179,21 +484,18 @@ func (r *postResolver) Comments(ctx context.Context, obj *model.Post, first *int
 	if after != nil {
 		err := cursor.ValidateAfter(after)
 		if err != nil {
-			return nil, errors.New("invalid after")
+			return nil, errs.ErrInvalidAfterCursor
 		}
 
 		path, err = cursor.GetPath(after)
 		if err != nil {
-			return nil, errors.New("invalid after")
+			return nil, errs.ErrInvalidAfterCursor
 		}
 	}
 
-	internalCommentsBranch, err := r.CommentQuery.GetCommentsBranchToPost(ctx, obj.ID, path)
+	internalCommentsBranch, err := r.CommentQuery.GetCommentsBranchToPost(ctx, obj.ID, path, commentOrderToInternalModel(order))
 	if err != nil {
-		if err != errs.ErrCommentsNotExist && err != errs.ErrPathNotExist {
-			err = errors.New("internal server error")
-		}
-		if err == errs.ErrCommentsNotExist {
+		if errors.Is(err, errs.ErrCommentsNotExist) {
 			obj.Comments = &model.CommentConnection{
 				Edges:    []*model.CommentEdge{},
 				PageInfo: &model.PageInfo{HasNextPage: false},
@@ -209,11 +511,58 @@ func (r *postResolver) Comments(ctx context.Context, obj *model.Post, first *int
 		return nil, err
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
 	return commentBranch, nil
 }
 
+// MyVote is the resolver for the myVote field.
+func (r *postResolver) MyVote(ctx context.Context, obj *model.Post) (*model.VoteValue, error) {
+	const op = "graph.Post.MyVote()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	vote, ok, err := r.PostQuery.GetMyVote(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	value := voteFromInternalModel(vote)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return &value, nil
+}
+
+// Reactions is the resolver for the reactions field.
+func (r *postResolver) Reactions(ctx context.Context, obj *model.Post) ([]*model.Reaction, error) {
+	return r.reactions(ctx, internalmodel.ReactionKey{Target: internalmodel.ReactionTargetPost, ID: obj.ID})
+}
+
+// Tags is the resolver for the tags field.
+func (r *postResolver) Tags(ctx context.Context, obj *model.Post) ([]string, error) {
+	const op = "graph.Post.Tags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	tags, err := r.PostQuery.GetPostTags(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+// HTML is the resolver for the html field.
+func (r *postResolver) HTML(ctx context.Context, obj *model.Post) (string, error) {
+	return r.Renderer.HTML(ctx, textFormatToInternalModel(&obj.Format), obj.Text), nil
+}
+
 const defaultFirst int = 5
 
 func paginateInternalBranch(internalComments []*internalmodel.Comment, firstInput *int32, after *string) (*model.CommentConnection, error) {
@@ -286,27 +635,38 @@ func paginateInternalBranch(internalComments []*internalmodel.Comment, firstInpu
 
 func commentFromInternalModel(internalComment *internalmodel.Comment) *model.Comment {
 	return &model.Comment{
-		ID:         internalComment.ID,
-		AuthorID:   internalComment.AuthorID,
-		PostID:     internalComment.PostID,
-		ParentID:   internalComment.ParentID,
-		Text:       internalComment.Text,
-		CreateDate: internalComment.CreateDate,
+		ID:               internalComment.ID,
+		AuthorID:         internalComment.AuthorID,
+		PostID:           internalComment.PostID,
+		ParentID:         internalComment.ParentID,
+		Text:             internalComment.Text,
+		CreateDate:       internalComment.CreateDate,
+		RepliesCount:     int32(internalComment.RepliesCount),
+		DescendantsCount: int32(internalComment.DescendantsCount),
+		Level:            int32(internalComment.Level()),
+		Score:            int32(internalComment.Score),
+		Format:           textFormatFromInternalModel(internalComment.Format),
 	}
 }
 
+func commentOrderToInternalModel(order *model.CommentOrder) internalmodel.CommentOrder {
+	if order != nil && *order == model.CommentOrderTop {
+		return internalmodel.CommentOrderTop
+	}
+	return internalmodel.CommentOrderOldest
+}
+
 // Posts is the resolver for the posts field.
-func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
+func (r *queryResolver) Posts(ctx context.Context, orderBy *model.PostOrder, tag *string, filter *model.PostFilter) ([]*model.Post, error) {
 	const op = "graph.Posts()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	internalPosts, err := r.PostQuery.GetAllPosts(ctx)
+	internalPosts, err := r.PostQuery.GetAllPosts(ctx, postsOptionsToInternalModel(orderBy, tag, filter))
 
 	if err != nil {
-		log.Error().Err(err).Msgf("%s end with error", op)
-		if err != errs.ErrPostsNotExist {
-			err = errors.New("internal server error")
+		if !errors.Is(err, errs.ErrPostsNotExist) {
+			return nil, err
 		}
 		posts := make([]*model.Post, 0)
 		return posts, nil
@@ -316,7 +676,7 @@ func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
 		posts[i] = postFromInternalModel(v)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return posts, err
 }
 
@@ -324,27 +684,160 @@ func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
 func (r *queryResolver) Post(ctx context.Context, postID int64) (*model.Post, error) {
 	const op = "graph.Post()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	postInternal, err := r.PostQuery.GetPost(ctx, postID)
 
 	if err != nil {
-		log.Error().Err(err).Msgf("%s end with error", op)
-		if err != errs.ErrPostNotExist {
-			err = errors.New("internal server error")
-		}
 		return nil, err
 	}
 
 	post := postFromInternalModel(postInternal)
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, err
 }
 
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, typeArg model.SearchType, first *int32, after *string) (*model.SearchConnection, error) {
+	const op = "graph.Search()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	limit := pageSize(first)
+	var offset int
+	if after != nil {
+		hitOffset, err := cursor.GetSearchOffset(after)
+		if err != nil {
+			return nil, errs.ErrInvalidAfterCursor
+		}
+		offset = hitOffset + 1
+	}
+
+	opts := internalmodel.SearchOptions{
+		Query:  query,
+		Type:   internalmodel.SearchPosts,
+		Offset: offset,
+		Limit:  limit + 1, // the extra hit tells whether there is the next page
+	}
+	if typeArg == model.SearchTypeComment {
+		opts.Type = internalmodel.SearchComments
+	}
+
+	hits, err := r.SearchQuery.Search(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(hits) > limit
+	hits = hits[:min(limit, len(hits))]
+
+	edges := make([]*model.SearchEdge, len(hits))
+	for i, hit := range hits {
+		edge := &model.SearchEdge{
+			Cursor:  cursor.CreateSearchCursor(offset + i),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		}
+		if hit.Comment != nil {
+			edge.Node = commentFromInternalModel(hit.Comment)
+		} else {
+			edge.Node = postFromInternalModel(hit.Post)
+		}
+		edges[i] = edge
+	}
+
+	var endCursor *string
+	if len(edges) > 0 {
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return &model.SearchConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{EndCursor: endCursor, HasNextPage: hasNextPage},
+	}, nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context) ([]*model.Tag, error) {
+	const op = "graph.Tags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	internalTags, err := r.PostQuery.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*model.Tag, len(internalTags))
+	for i, v := range internalTags {
+		tags[i] = &model.Tag{Name: v.Name, PostsCount: int32(v.PostsCount)}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+// UserActivity is the resolver for the userActivity field.
+func (r *queryResolver) UserActivity(ctx context.Context, authorID uuid.UUID, first *int32, after *string) (*model.ActivityConnection, error) {
+	const op = "graph.UserActivity()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var afterKey *internalmodel.ActivityKey
+	if after != nil {
+		key, err := cursor.GetActivityKey(after)
+		if err != nil {
+			return nil, errs.ErrInvalidAfterCursor
+		}
+		afterKey = &key
+	}
+
+	limit := pageSize(first)
+	// the extra item tells whether there is the next page
+	items, err := r.ActivityQuery.GetUserActivity(ctx, authorID, afterKey, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNextPage := len(items) > limit
+	items = items[:min(limit, len(items))]
+
+	edges := make([]*model.ActivityEdge, len(items))
+	for i, item := range items {
+		edge := &model.ActivityEdge{
+			Cursor:    cursor.CreateActivityCursor(item.Key()),
+			PostTitle: item.PostTitle,
+		}
+		if item.Comment != nil {
+			edge.Node = commentFromInternalModel(item.Comment)
+		} else {
+			edge.Node = postFromInternalModel(item.Post)
+		}
+		edges[i] = edge
+	}
+
+	var endCursor *string
+	if len(edges) > 0 {
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return &model.ActivityConnection{
+		Edges:    edges,
+		PageInfo: &model.PageInfo{EndCursor: endCursor, HasNextPage: hasNextPage},
+	}, nil
+}
+
+// Reactions is the resolver for the reactions field.
+func (r *reactionEventResolver) Reactions(ctx context.Context, obj *model.ReactionEvent) ([]*model.Reaction, error) {
+	return r.reactions(ctx, reactionKeyToInternalModel(obj.Target, obj.TargetID))
+}
+
 // CommentAdded is the resolver for the commentAdded field.
 func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID int64) (<-chan *model.Comment, error) {
 	const op = "graph.CommentAdded()"
-	log.Debug().Msgf("%s subscription init", op)
+	log.Ctx(ctx).Debug().Msgf("%s subscription init", op)
 	ch := make(chan *model.Comment, 10)
 
 	go func() {
@@ -354,7 +847,7 @@ func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID int64) (
 		for {
 			select {
 			case <-ctx.Done():
-				log.Debug().Msgf("%s subscription closed", op)
+				log.Ctx(ctx).Debug().Msgf("%s subscription closed", op)
 				return
 			}
 		}
@@ -363,6 +856,36 @@ func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID int64) (
 
 }
 
+// ReactionChanged is the resolver for the reactionChanged field.
+func (r *subscriptionResolver) ReactionChanged(ctx context.Context, postID int64) (<-chan *model.ReactionEvent, error) {
+	const op = "graph.ReactionChanged()"
+	log.Ctx(ctx).Debug().Msgf("%s subscription init", op)
+	ch := make(chan *model.ReactionEvent, 10)
+
+	r.ReactionSubscribers.Sub(postID, ch)
+	go func() {
+		<-ctx.Done()
+		r.ReactionSubscribers.CloseSub(postID, ch)
+		log.Ctx(ctx).Debug().Msgf("%s subscription closed", op)
+	}()
+	return ch, nil
+}
+
+// PostAdded is the resolver for the postAdded field.
+func (r *subscriptionResolver) PostAdded(ctx context.Context) (<-chan *model.Post, error) {
+	const op = "graph.PostAdded()"
+	log.Ctx(ctx).Debug().Msgf("%s subscription init", op)
+	ch := make(chan *model.Post, 10)
+
+	r.PostSubscribers.Sub(allPosts, ch)
+	go func() {
+		<-ctx.Done()
+		r.PostSubscribers.CloseSub(allPosts, ch)
+		log.Ctx(ctx).Debug().Msgf("%s subscription closed", op)
+	}()
+	return ch, nil
+}
+
 // Comment returns CommentResolver implementation.
 func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
 
@@ -375,6 +898,9 @@ func (r *Resolver) Post() PostResolver { return &postResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// ReactionEvent returns ReactionEventResolver implementation.
+func (r *Resolver) ReactionEvent() ReactionEventResolver { return &reactionEventResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -382,4 +908,5 @@ type commentResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type postResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type reactionEventResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
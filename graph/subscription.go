@@ -3,22 +3,23 @@ package graph
 import (
 	"slices"
 	"sync"
-
-	"github.com/nabishec/ozon_habr_api/graph/model"
+	"sync/atomic"
 )
 
-type Subscribers struct {
-	Subscribers map[int64][]chan *model.Comment
+// Subscribers delivers the events of type T to the subscribers of a post.
+type Subscribers[T any] struct {
+	Subscribers map[int64][]chan T
 	mu          sync.Mutex
+	dropped     atomic.Uint64
 }
 
-func NewSubscribers() *Subscribers {
-	return &Subscribers{
-		Subscribers: make(map[int64][]chan *model.Comment), // on postID chans
+func NewSubscribers[T any]() *Subscribers[T] {
+	return &Subscribers[T]{
+		Subscribers: make(map[int64][]chan T), // on postID chans
 	}
 }
 
-func (s *Subscribers) Pub(postID int64, comment *model.Comment) {
+func (s *Subscribers[T]) Pub(postID int64, event T) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
 
@@ -26,7 +27,7 @@ func (s *Subscribers) Pub(postID int64, comment *model.Comment) {
 	if !ok {
 		return
 	}
-	var activeChannels []chan *model.Comment
+	var activeChannels []chan T
 
 	for _, ch := range chArray {
 		func() {
@@ -37,7 +38,12 @@ func (s *Subscribers) Pub(postID int64, comment *model.Comment) {
 				}
 				activeChannels = append(activeChannels, ch)
 			}()
-			ch <- comment
+			// a slow subscriber must not block publishing for the others
+			select {
+			case ch <- event:
+			default:
+				s.dropped.Add(1)
+			}
 		}()
 	}
 
@@ -46,13 +52,13 @@ func (s *Subscribers) Pub(postID int64, comment *model.Comment) {
 	}
 }
 
-func (s *Subscribers) Sub(postID int64, ch chan *model.Comment) {
+func (s *Subscribers[T]) Sub(postID int64, ch chan T) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
 	s.Subscribers[postID] = append(s.Subscribers[postID], ch)
 }
 
-func (s *Subscribers) CloseSub(postID int64, ch chan *model.Comment) {
+func (s *Subscribers[T]) CloseSub(postID int64, ch chan T) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
 
@@ -72,7 +78,37 @@ func (s *Subscribers) CloseSub(postID int64, ch chan *model.Comment) {
 	}
 }
 
-func closeChan(ch chan *model.Comment) {
+// Active returns the number of active subscriptions.
+func (s *Subscribers[T]) Active() int {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	var active int
+	for _, chArray := range s.Subscribers {
+		active += len(chArray)
+	}
+	return active
+}
+
+// Dropped returns the number of events that weren't delivered because a subscriber's buffer was full.
+func (s *Subscribers[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// CloseAll closes every subscriber channel, it is used when the server shuts down.
+func (s *Subscribers[T]) CloseAll() {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	for postID, chArray := range s.Subscribers {
+		for _, ch := range chArray {
+			closeChan(ch)
+		}
+		delete(s.Subscribers, postID)
+	}
+}
+
+func closeChan[T any](ch chan T) {
 	defer func() {
 		if r := recover(); r != nil {
 			return
@@ -0,0 +1,58 @@
+package accesslog
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/operation"
+	"github.com/nabishec/ozon_habr_api/internal/querylimit"
+	"github.com/rs/zerolog/log"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// GraphQL is a gqlgen extension that writes one structured log line per
+// operation, every subscription event gets its own line.
+type GraphQL struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = GraphQL{}
+
+func (GraphQL) ExtensionName() string {
+	return "AccessLog"
+}
+
+func (GraphQL) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+
+	name, opType := operation.Describe(ctx)
+
+	event := log.Ctx(ctx).Info().
+		Str("operation", name).
+		Str("type", opType)
+
+	if graphql.HasOperationContext(ctx) {
+		start := graphql.GetOperationContext(ctx).Stats.OperationStart
+		// a subscription event would report the age of the subscription
+		if opType != string(ast.Subscription) && !start.IsZero() {
+			event = event.Dur("duration", time.Since(start))
+		}
+		if stats := querylimit.GetStats(ctx); stats != nil {
+			event = event.Int("depth", stats.Depth).Int("complexity", stats.Complexity)
+		}
+	}
+
+	errCount := 0
+	if resp != nil {
+		errCount = len(resp.Errors)
+	}
+	event.Int("errors", errCount).Msg("graphql operation")
+
+	return resp
+}
@@ -0,0 +1,551 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
+
+const (
+	PersistedQueriesAutomatic = "automatic"
+	PersistedQueriesTrusted   = "trusted"
+)
+
+const (
+	CommentDepthReject  = "reject"
+	CommentDepthFlatten = "flatten"
+)
+
+// maxReactionLength bounds an allowed reaction, emoji with modifiers take
+// several code points.
+const maxReactionLength = 32
+
+const (
+	TracingNone   = "none"
+	TracingStdout = "stdout"
+	TracingFile   = "file"
+	TracingOTLP   = "otlp"
+)
+
+type Config struct {
+	Storage   string    `yaml:"storage"`
+	Log       Log       `yaml:"log"`
+	Server    Server    `yaml:"server"`
+	Database  Database  `yaml:"database"`
+	Cache     Cache     `yaml:"cache"`
+	Tracing   Tracing   `yaml:"tracing"`
+	RateLimit RateLimit `yaml:"rateLimit"`
+	Reactions Reactions `yaml:"reactions"`
+	Posts     Posts     `yaml:"posts"`
+	Comments  Comments  `yaml:"comments"`
+
+	Validation Validation `yaml:"validation"`
+
+	PersistedQueries PersistedQueries `yaml:"persistedQueries"`
+}
+
+type Log struct {
+	Debug   bool `yaml:"debug"`
+	Console bool `yaml:"console"`
+}
+
+type Server struct {
+	Port                  int           `yaml:"port"`
+	ReadTimeout           time.Duration `yaml:"readTimeout"`
+	WriteTimeout          time.Duration `yaml:"writeTimeout"`
+	IdleTimeout           time.Duration `yaml:"idleTimeout"`
+	ShutdownTimeout       time.Duration `yaml:"shutdownTimeout"`
+	HealthTimeout         time.Duration `yaml:"healthTimeout"`
+	ComplexityLimit       int           `yaml:"complexityLimit"`
+	MaxDepth              int           `yaml:"maxDepth"`
+	QueryCacheSize        int           `yaml:"queryCacheSize"`
+	APQCacheSize          int           `yaml:"apqCacheSize"`
+	KeepAlivePingInterval time.Duration `yaml:"keepAlivePingInterval"`
+	AllowedOrigins        []string      `yaml:"allowedOrigins"`
+	Introspection         bool          `yaml:"introspection"`
+}
+
+type Database struct {
+	Protocol string `yaml:"protocol"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Name     string `yaml:"name"`
+	Options  string `yaml:"options"`
+
+	MigrationsDir string `yaml:"migrationsDir"`
+}
+
+type Cache struct {
+	Shards         map[string]string `yaml:"shards"`
+	Password       string            `yaml:"password"`
+	DB             int               `yaml:"db"`
+	MaxRetries     int               `yaml:"maxRetries"`
+	DialTimeout    time.Duration     `yaml:"dialTimeout"`
+	TTL            time.Duration     `yaml:"ttl"`
+	LocalCacheSize int               `yaml:"localCacheSize"`
+	LocalCacheTTL  time.Duration     `yaml:"localCacheTTL"`
+}
+
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"`
+	File        string  `yaml:"file"`
+	Endpoint    string  `yaml:"endpoint"`
+	SampleRatio float64 `yaml:"sampleRatio"`
+	ServiceName string  `yaml:"serviceName"`
+}
+
+type PersistedQueries struct {
+	// Mode is automatic (any document, hashes are cached) or trusted (only the
+	// documents of the manifest)
+	Mode     string `yaml:"mode"`
+	Manifest string `yaml:"manifest"`
+	// CacheTTL is the lifetime of automatic persisted queries in redis
+	CacheTTL time.Duration `yaml:"cacheTTL"`
+}
+
+type Reactions struct {
+	// Allowed is the set of emoji users may react with
+	Allowed []string `yaml:"allowed"`
+}
+
+type Posts struct {
+	// PublishInterval is how often the scheduled posts are checked for being due
+	PublishInterval time.Duration `yaml:"publishInterval"`
+	// HTMLCacheSize is how many rendered texts are kept in memory with the
+	// in-memory storage, redis keeps them for cache.ttl otherwise
+	HTMLCacheSize int `yaml:"htmlCacheSize"`
+}
+
+// Comments is the nesting limit of the posts without their own.
+type Comments struct {
+	// MaxDepth is the deepest level of the replies, 0 disables the limit
+	MaxDepth int `yaml:"maxDepth"`
+	// DepthMode is what happens to the deeper replies: 'reject' or 'flatten'
+	// to the deepest allowed ancestor
+	DepthMode string `yaml:"depthMode"`
+}
+
+// Validation bounds the texts of the users in characters.
+type Validation struct {
+	TitleMaxLength       int `yaml:"titleMaxLength"`
+	PostTextMaxLength    int `yaml:"postTextMaxLength"`
+	CommentTextMaxLength int `yaml:"commentTextMaxLength"`
+}
+
+type RateLimit struct {
+	Enabled bool `yaml:"enabled"`
+	// TrustProxy takes the client ip from X-Forwarded-For, enable it only
+	// behind a proxy that sets the header
+	TrustProxy bool `yaml:"trustProxy"`
+	// Rules are keyed by the root field name, e.g. addComment
+	Rules map[string]RateLimitRule `yaml:"rules"`
+}
+
+type RateLimitRule struct {
+	PerAuthor Limit `yaml:"perAuthor"`
+	PerIP     Limit `yaml:"perIP"`
+}
+
+// Limit allows Requests per Period with bursts up to Requests, zero Requests
+// disables the limit.
+type Limit struct {
+	Requests int           `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+}
+
+// Default returns the configuration the service used before it became configurable.
+func Default() *Config {
+	return &Config{
+		Storage: StoragePostgres,
+		Server: Server{
+			Port:                  8080,
+			ReadTimeout:           4 * time.Second,
+			WriteTimeout:          4 * time.Second,
+			IdleTimeout:           60 * time.Second,
+			ShutdownTimeout:       15 * time.Second,
+			HealthTimeout:         2 * time.Second,
+			ComplexityLimit:       400, // limit to +- 50 commments because there is not much space on web page
+			MaxDepth:              15,  // comments of a post with three levels of replies
+			QueryCacheSize:        1000,
+			APQCacheSize:          100,
+			KeepAlivePingInterval: 10 * time.Second,
+			AllowedOrigins:        []string{"http://localhost:8080", "https://ozonhabr.com"},
+			Introspection:         true,
+		},
+		Database: Database{
+			Protocol:      "postgres",
+			Port:          5432,
+			MigrationsDir: "migrations",
+		},
+		Cache: Cache{
+			Shards:         map[string]string{},
+			MaxRetries:     3,
+			DialTimeout:    50 * time.Millisecond,
+			TTL:            30 * time.Minute,
+			LocalCacheSize: 1000,
+			LocalCacheTTL:  time.Minute,
+		},
+		Tracing: Tracing{
+			Exporter:    TracingNone,
+			SampleRatio: 1,
+			ServiceName: "ozon_habr_api",
+		},
+		PersistedQueries: PersistedQueries{
+			Mode:     PersistedQueriesAutomatic,
+			CacheTTL: 24 * time.Hour,
+		},
+		Reactions: Reactions{
+			Allowed: []string{"👍", "👎", "🔥", "😂", "❤️", "🤔"},
+		},
+		Posts: Posts{
+			PublishInterval: 10 * time.Second,
+			HTMLCacheSize:   1000,
+		},
+		Comments: Comments{
+			MaxDepth:  10,
+			DepthMode: CommentDepthFlatten,
+		},
+		Validation: Validation{
+			TitleMaxLength:       200,
+			PostTextMaxLength:    50000,
+			CommentTextMaxLength: 2000,
+		},
+		RateLimit: RateLimit{
+			Enabled: true,
+			Rules: map[string]RateLimitRule{
+				"addPost": {
+					PerAuthor: Limit{Requests: 5, Period: time.Minute},
+					PerIP:     Limit{Requests: 100, Period: time.Minute},
+				},
+				"addComment": {
+					PerAuthor: Limit{Requests: 10, Period: time.Minute},
+					PerIP:     Limit{Requests: 100, Period: time.Minute},
+				},
+				"updateEnableComment": {
+					PerIP: Limit{Requests: 100, Period: time.Minute},
+				},
+				"publishPost": {
+					PerIP: Limit{Requests: 100, Period: time.Minute},
+				},
+				"schedulePost": {
+					PerIP: Limit{Requests: 100, Period: time.Minute},
+				},
+				"votePost": {
+					PerAuthor: Limit{Requests: 30, Period: time.Minute},
+					PerIP:     Limit{Requests: 300, Period: time.Minute},
+				},
+				"voteComment": {
+					PerAuthor: Limit{Requests: 60, Period: time.Minute},
+					PerIP:     Limit{Requests: 300, Period: time.Minute},
+				},
+				"addReaction": {
+					PerAuthor: Limit{Requests: 60, Period: time.Minute},
+					PerIP:     Limit{Requests: 300, Period: time.Minute},
+				},
+				"removeReaction": {
+					PerAuthor: Limit{Requests: 60, Period: time.Minute},
+					PerIP:     Limit{Requests: 300, Period: time.Minute},
+				},
+			},
+		},
+	}
+}
+
+// Load builds the configuration from the defaults, the yaml file (if path isn't
+// empty) and the environment, in that order of precedence.
+func Load(path string) (*Config, error) {
+	const op = "internal.config.Load()"
+
+	cfg := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+
+		err = yaml.Unmarshal(data, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s:failed parse %s:%w", op, path, err)
+		}
+	}
+
+	err := cfg.applyEnv()
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) applyEnv() error {
+	var e envReader
+
+	e.string(&c.Storage, "STORAGE_TYPE")
+
+	e.int(&c.Server.Port, "SERVER_PORT")
+	e.duration(&c.Server.ReadTimeout, "TIMEOUT")
+	e.duration(&c.Server.WriteTimeout, "TIMEOUT")
+	e.duration(&c.Server.IdleTimeout, "IDLE_TIMEOUT")
+	e.duration(&c.Server.ShutdownTimeout, "SHUTDOWN_TIMEOUT")
+	e.duration(&c.Server.HealthTimeout, "HEALTH_TIMEOUT")
+	e.int(&c.Server.ComplexityLimit, "COMPLEXITY_LIMIT")
+	e.int(&c.Server.MaxDepth, "MAX_QUERY_DEPTH")
+	e.strings(&c.Server.AllowedOrigins, "ALLOWED_ORIGINS")
+	e.bool(&c.Server.Introspection, "INTROSPECTION")
+
+	e.string(&c.Database.Protocol, "DB_PROTOCOL")
+	e.string(&c.Database.User, "DB_USER")
+	e.string(&c.Database.Password, "DB_PASSWORD")
+	e.string(&c.Database.Host, "DB_HOST")
+	e.int(&c.Database.Port, "DB_PORT")
+	e.string(&c.Database.Name, "DB_NAME")
+	e.string(&c.Database.Options, "DB_OPTIONS")
+	e.string(&c.Database.MigrationsDir, "MIGRATIONS_DIR")
+
+	e.shard(c.Cache.Shards, "shard1", "REDIS_HOST1", "REDIS_PORT1")
+	e.shard(c.Cache.Shards, "shard2", "REDIS_HOST2", "REDIS_PORT2")
+	e.string(&c.Cache.Password, "REDIS_PASSWORD")
+	e.int(&c.Cache.DB, "REDIS_DB")
+	e.duration(&c.Cache.TTL, "CACHE_TTL")
+
+	e.string(&c.Tracing.Exporter, "TRACING_EXPORTER")
+	e.string(&c.Tracing.File, "TRACING_FILE")
+	e.string(&c.Tracing.Endpoint, "TRACING_ENDPOINT")
+	e.float(&c.Tracing.SampleRatio, "TRACING_SAMPLE_RATIO")
+	e.string(&c.Tracing.ServiceName, "TRACING_SERVICE_NAME")
+
+	e.string(&c.PersistedQueries.Mode, "PERSISTED_QUERIES_MODE")
+	e.string(&c.PersistedQueries.Manifest, "PERSISTED_QUERIES_MANIFEST")
+	e.duration(&c.PersistedQueries.CacheTTL, "PERSISTED_QUERIES_CACHE_TTL")
+
+	e.strings(&c.Reactions.Allowed, "REACTIONS_ALLOWED")
+
+	e.duration(&c.Posts.PublishInterval, "PUBLISH_INTERVAL")
+	e.int(&c.Posts.HTMLCacheSize, "HTML_CACHE_SIZE")
+
+	e.int(&c.Comments.MaxDepth, "COMMENTS_MAX_DEPTH")
+	e.string(&c.Comments.DepthMode, "COMMENTS_DEPTH_MODE")
+
+	e.int(&c.Validation.TitleMaxLength, "TITLE_MAX_LENGTH")
+	e.int(&c.Validation.PostTextMaxLength, "POST_TEXT_MAX_LENGTH")
+	e.int(&c.Validation.CommentTextMaxLength, "COMMENT_TEXT_MAX_LENGTH")
+
+	e.bool(&c.RateLimit.Enabled, "RATE_LIMIT_ENABLED")
+	e.bool(&c.RateLimit.TrustProxy, "RATE_LIMIT_TRUST_PROXY")
+
+	return errors.Join(e.errs...)
+}
+
+// Validate checks the whole configuration and reports every invalid field at once.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, field, msg string) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", field, msg))
+		}
+	}
+
+	check(c.Storage == StoragePostgres || c.Storage == StorageMemory, "storage", "must be 'postgres' or 'memory'")
+
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "server.port", "must be between 1 and 65535")
+	check(c.Server.ReadTimeout > 0, "server.readTimeout", "must be positive")
+	check(c.Server.WriteTimeout > 0, "server.writeTimeout", "must be positive")
+	check(c.Server.IdleTimeout > 0, "server.idleTimeout", "must be positive")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdownTimeout", "must be positive")
+	check(c.Server.HealthTimeout > 0, "server.healthTimeout", "must be positive")
+	check(c.Server.ComplexityLimit > 0, "server.complexityLimit", "must be positive")
+	check(c.Server.MaxDepth > 0, "server.maxDepth", "must be positive")
+	check(c.Server.QueryCacheSize > 0, "server.queryCacheSize", "must be positive")
+	check(c.Server.APQCacheSize > 0, "server.apqCacheSize", "must be positive")
+	check(c.Server.KeepAlivePingInterval > 0, "server.keepAlivePingInterval", "must be positive")
+
+	if c.Storage == StoragePostgres {
+		check(c.Database.Protocol != "", "database.protocol", "must be set")
+		check(c.Database.User != "", "database.user", "must be set")
+		check(c.Database.Password != "", "database.password", "must be set")
+		check(c.Database.Host != "", "database.host", "must be set")
+		check(c.Database.Port > 0 && c.Database.Port <= 65535, "database.port", "must be between 1 and 65535")
+		check(c.Database.Name != "", "database.name", "must be set")
+		check(c.Database.MigrationsDir != "", "database.migrationsDir", "must be set")
+
+		check(len(c.Cache.Shards) > 0, "cache.shards", "at least one shard must be set")
+		for name, addr := range c.Cache.Shards {
+			_, port, err := net.SplitHostPort(addr)
+			check(err == nil && port != "", "cache.shards."+name, "must be in host:port form")
+		}
+		check(c.Cache.DB >= 0, "cache.db", "must not be negative")
+		check(c.Cache.MaxRetries >= 0, "cache.maxRetries", "must not be negative")
+		check(c.Cache.DialTimeout > 0, "cache.dialTimeout", "must be positive")
+		check(c.Cache.TTL > 0, "cache.ttl", "must be positive")
+		check(c.Cache.LocalCacheSize > 0, "cache.localCacheSize", "must be positive")
+		check(c.Cache.LocalCacheTTL > 0, "cache.localCacheTTL", "must be positive")
+	}
+
+	switch c.Tracing.Exporter {
+	case TracingNone, TracingStdout:
+	case TracingFile:
+		check(c.Tracing.File != "", "tracing.file", "must be set for the file exporter")
+	case TracingOTLP:
+		check(c.Tracing.Endpoint != "", "tracing.endpoint", "must be set for the otlp exporter")
+	default:
+		check(false, "tracing.exporter", "must be 'none', 'stdout', 'file' or 'otlp'")
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sampleRatio", "must be between 0 and 1")
+	check(c.Tracing.ServiceName != "", "tracing.serviceName", "must be set")
+
+	switch c.PersistedQueries.Mode {
+	case PersistedQueriesAutomatic:
+		check(c.PersistedQueries.CacheTTL > 0, "persistedQueries.cacheTTL", "must be positive")
+	case PersistedQueriesTrusted:
+		check(c.PersistedQueries.Manifest != "", "persistedQueries.manifest", "must be set in trusted mode")
+	default:
+		check(false, "persistedQueries.mode", "must be 'automatic' or 'trusted'")
+	}
+
+	check(len(c.Reactions.Allowed) > 0, "reactions.allowed", "must not be empty")
+	for _, emoji := range c.Reactions.Allowed {
+		check(emoji != "" && utf8.ValidString(emoji) && len(emoji) <= maxReactionLength, "reactions.allowed", fmt.Sprintf("%q must be non-empty valid UTF-8 of at most %d bytes", emoji, maxReactionLength))
+	}
+
+	check(c.Posts.PublishInterval > 0, "posts.publishInterval", "must be positive")
+	check(c.Posts.HTMLCacheSize > 0, "posts.htmlCacheSize", "must be positive")
+
+	check(c.Comments.MaxDepth >= 0, "comments.maxDepth", "must not be negative")
+	check(c.Comments.DepthMode == CommentDepthReject || c.Comments.DepthMode == CommentDepthFlatten, "comments.depthMode", "must be 'reject' or 'flatten'")
+
+	check(c.Validation.TitleMaxLength > 0, "validation.titleMaxLength", "must be positive")
+	check(c.Validation.PostTextMaxLength > 0, "validation.postTextMaxLength", "must be positive")
+	check(c.Validation.CommentTextMaxLength > 0, "validation.commentTextMaxLength", "must be positive")
+
+	for field, rule := range c.RateLimit.Rules {
+		for name, limit := range map[string]Limit{"perAuthor": rule.PerAuthor, "perIP": rule.PerIP} {
+			prefix := "rateLimit.rules." + field + "." + name
+			check(limit.Requests >= 0, prefix+".requests", "must not be negative")
+			check(limit.Requests == 0 || limit.Period > 0, prefix+".period", "must be positive")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DSN builds the data source name for the database.
+func (d Database) DSN() string {
+	dsn := d.Protocol + "://" + d.User + ":" + d.Password + "@" +
+		d.Host + ":" + strconv.Itoa(d.Port) + "/" + d.Name
+	if d.Options != "" {
+		dsn += "?" + d.Options
+	}
+	return dsn
+}
+
+// Redacted returns a copy of the configuration without secrets, safe for printing.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	if redacted.Database.Password != "" {
+		redacted.Database.Password = "***"
+	}
+	if redacted.Cache.Password != "" {
+		redacted.Cache.Password = "***"
+	}
+	return &redacted
+}
+
+// envReader overrides config fields by the environment and collects parse errors.
+type envReader struct {
+	errs []error
+}
+
+func (e *envReader) string(field *string, name string) {
+	if v, ok := os.LookupEnv(name); ok && v != "" {
+		*field = v
+	}
+}
+
+func (e *envReader) int(field *int, name string) {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		return
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: must be a number", name))
+		return
+	}
+	*field = n
+}
+
+func (e *envReader) bool(field *bool, name string) {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: must be true or false", name))
+		return
+	}
+	*field = b
+}
+
+func (e *envReader) float(field *float64, name string) {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		return
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: must be a number", name))
+		return
+	}
+	*field = f
+}
+
+func (e *envReader) duration(field *time.Duration, name string) {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: must be a duration like 4s", name))
+		return
+	}
+	*field = d
+}
+
+func (e *envReader) strings(field *[]string, name string) {
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		return
+	}
+	var values []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	*field = values
+}
+
+func (e *envReader) shard(shards map[string]string, shard, hostName, portName string) {
+	host, port := os.Getenv(hostName), os.Getenv(portName)
+	if host == "" && port == "" {
+		return
+	}
+	shards[shard] = net.JoinHostPort(host, port)
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("Defaults are valid for memory storage", func(t *testing.T) {
+		cfg := Default()
+		cfg.Storage = StorageMemory
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("File is overridden by env", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		err := os.WriteFile(path, []byte("storage: memory\nserver:\n  port: 9000\n  idleTimeout: 2m\n"), 0o600)
+		require.NoError(t, err)
+
+		t.Setenv("SERVER_PORT", "9100")
+
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, StorageMemory, cfg.Storage)
+		assert.Equal(t, 9100, cfg.Server.Port)
+		assert.Equal(t, 2*time.Minute, cfg.Server.IdleTimeout)
+		assert.Equal(t, 400, cfg.Server.ComplexityLimit)
+	})
+
+	t.Run("Redis shards from env", func(t *testing.T) {
+		t.Setenv("REDIS_HOST1", "redis1")
+		t.Setenv("REDIS_PORT1", "6379")
+
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.Equal(t, "redis1:6379", cfg.Cache.Shards["shard1"])
+	})
+
+	t.Run("Invalid env value", func(t *testing.T) {
+		t.Setenv("TIMEOUT", "four seconds")
+
+		_, err := Load("")
+		assert.ErrorContains(t, err, "TIMEOUT")
+	})
+
+	t.Run("Tracing from env", func(t *testing.T) {
+		t.Setenv("TRACING_EXPORTER", "file")
+		t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
+
+		cfg, err := Load("")
+		require.NoError(t, err)
+		assert.Equal(t, TracingFile, cfg.Tracing.Exporter)
+		assert.Equal(t, 0.25, cfg.Tracing.SampleRatio)
+		assert.ErrorContains(t, cfg.Validate(), "tracing.file")
+	})
+
+	t.Run("Validation reports every field", func(t *testing.T) {
+		cfg := Default()
+		cfg.Server.Port = 0
+		cfg.Cache.Shards = map[string]string{"shard1": "redis1"}
+
+		err := cfg.Validate()
+		assert.ErrorContains(t, err, "server.port")
+		assert.ErrorContains(t, err, "database.user")
+		assert.ErrorContains(t, err, "cache.shards.shard1")
+	})
+}
+
+func TestDSN(t *testing.T) {
+	db := Database{
+		Protocol: "postgres",
+		User:     "ozon",
+		Password: "secret",
+		Host:     "db",
+		Port:     5432,
+		Name:     "ozon_habr",
+		Options:  "sslmode=disable",
+	}
+	assert.Equal(t, "postgres://ozon:secret@db:5432/ozon_habr?sslmode=disable", db.DSN())
+}
@@ -0,0 +1,512 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package activityquery
+
+//go:generate minimock -i github.com/nabishec/ozon_habr_api/internal/handlers/activity_query.ActivityQueryImp -o activity_query_imp_mock_test.go -n ActivityQueryImpMock -p activityquery
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+// ActivityQueryImpMock implements ActivityQueryImp
+type ActivityQueryImpMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUserActivity          func(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) (apa1 []*model.ActivityItem, err error)
+	funcGetUserActivityOrigin    string
+	inspectFuncGetUserActivity   func(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool)
+	afterGetUserActivityCounter  uint64
+	beforeGetUserActivityCounter uint64
+	GetUserActivityMock          mActivityQueryImpMockGetUserActivity
+}
+
+// NewActivityQueryImpMock returns a mock for ActivityQueryImp
+func NewActivityQueryImpMock(t minimock.Tester) *ActivityQueryImpMock {
+	m := &ActivityQueryImpMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserActivityMock = mActivityQueryImpMockGetUserActivity{mock: m}
+	m.GetUserActivityMock.callArgs = []*ActivityQueryImpMockGetUserActivityParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mActivityQueryImpMockGetUserActivity struct {
+	optional           bool
+	mock               *ActivityQueryImpMock
+	defaultExpectation *ActivityQueryImpMockGetUserActivityExpectation
+	expectations       []*ActivityQueryImpMockGetUserActivityExpectation
+
+	callArgs []*ActivityQueryImpMockGetUserActivityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ActivityQueryImpMockGetUserActivityExpectation specifies expectation struct of the ActivityQueryImp.GetUserActivity
+type ActivityQueryImpMockGetUserActivityExpectation struct {
+	mock               *ActivityQueryImpMock
+	params             *ActivityQueryImpMockGetUserActivityParams
+	paramPtrs          *ActivityQueryImpMockGetUserActivityParamPtrs
+	expectationOrigins ActivityQueryImpMockGetUserActivityExpectationOrigins
+	results            *ActivityQueryImpMockGetUserActivityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ActivityQueryImpMockGetUserActivityParams contains parameters of the ActivityQueryImp.GetUserActivity
+type ActivityQueryImpMockGetUserActivityParams struct {
+	ctx         context.Context
+	authorID    uuid.UUID
+	after       *model.ActivityKey
+	limit       int
+	unpublished bool
+}
+
+// ActivityQueryImpMockGetUserActivityParamPtrs contains pointers to parameters of the ActivityQueryImp.GetUserActivity
+type ActivityQueryImpMockGetUserActivityParamPtrs struct {
+	ctx         *context.Context
+	authorID    *uuid.UUID
+	after       **model.ActivityKey
+	limit       *int
+	unpublished *bool
+}
+
+// ActivityQueryImpMockGetUserActivityResults contains results of the ActivityQueryImp.GetUserActivity
+type ActivityQueryImpMockGetUserActivityResults struct {
+	apa1 []*model.ActivityItem
+	err  error
+}
+
+// ActivityQueryImpMockGetUserActivityOrigins contains origins of expectations of the ActivityQueryImp.GetUserActivity
+type ActivityQueryImpMockGetUserActivityExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAuthorID    string
+	originAfter       string
+	originLimit       string
+	originUnpublished string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Optional() *mActivityQueryImpMockGetUserActivity {
+	mmGetUserActivity.optional = true
+	return mmGetUserActivity
+}
+
+// Expect sets up expected params for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Expect(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserActivity.defaultExpectation.params = &ActivityQueryImpMockGetUserActivityParams{ctx, authorID, after, limit, unpublished}
+	mmGetUserActivity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserActivity.expectations {
+		if minimock.Equal(e.params, mmGetUserActivity.defaultExpectation.params) {
+			mmGetUserActivity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserActivity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserActivity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) ExpectCtxParam1(ctx context.Context) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.params != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Expect")
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs == nil {
+		mmGetUserActivity.defaultExpectation.paramPtrs = &ActivityQueryImpMockGetUserActivityParamPtrs{}
+	}
+	mmGetUserActivity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserActivity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserActivity
+}
+
+// ExpectAuthorIDParam2 sets up expected param authorID for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) ExpectAuthorIDParam2(authorID uuid.UUID) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.params != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Expect")
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs == nil {
+		mmGetUserActivity.defaultExpectation.paramPtrs = &ActivityQueryImpMockGetUserActivityParamPtrs{}
+	}
+	mmGetUserActivity.defaultExpectation.paramPtrs.authorID = &authorID
+	mmGetUserActivity.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmGetUserActivity
+}
+
+// ExpectAfterParam3 sets up expected param after for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) ExpectAfterParam3(after *model.ActivityKey) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.params != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Expect")
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs == nil {
+		mmGetUserActivity.defaultExpectation.paramPtrs = &ActivityQueryImpMockGetUserActivityParamPtrs{}
+	}
+	mmGetUserActivity.defaultExpectation.paramPtrs.after = &after
+	mmGetUserActivity.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmGetUserActivity
+}
+
+// ExpectLimitParam4 sets up expected param limit for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) ExpectLimitParam4(limit int) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.params != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Expect")
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs == nil {
+		mmGetUserActivity.defaultExpectation.paramPtrs = &ActivityQueryImpMockGetUserActivityParamPtrs{}
+	}
+	mmGetUserActivity.defaultExpectation.paramPtrs.limit = &limit
+	mmGetUserActivity.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetUserActivity
+}
+
+// ExpectUnpublishedParam5 sets up expected param unpublished for ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) ExpectUnpublishedParam5(unpublished bool) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{}
+	}
+
+	if mmGetUserActivity.defaultExpectation.params != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Expect")
+	}
+
+	if mmGetUserActivity.defaultExpectation.paramPtrs == nil {
+		mmGetUserActivity.defaultExpectation.paramPtrs = &ActivityQueryImpMockGetUserActivityParamPtrs{}
+	}
+	mmGetUserActivity.defaultExpectation.paramPtrs.unpublished = &unpublished
+	mmGetUserActivity.defaultExpectation.expectationOrigins.originUnpublished = minimock.CallerInfo(1)
+
+	return mmGetUserActivity
+}
+
+// Inspect accepts an inspector function that has same arguments as the ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Inspect(f func(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool)) *mActivityQueryImpMockGetUserActivity {
+	if mmGetUserActivity.mock.inspectFuncGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("Inspect function is already set for ActivityQueryImpMock.GetUserActivity")
+	}
+
+	mmGetUserActivity.mock.inspectFuncGetUserActivity = f
+
+	return mmGetUserActivity
+}
+
+// Return sets up results that will be returned by ActivityQueryImp.GetUserActivity
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Return(apa1 []*model.ActivityItem, err error) *ActivityQueryImpMock {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	if mmGetUserActivity.defaultExpectation == nil {
+		mmGetUserActivity.defaultExpectation = &ActivityQueryImpMockGetUserActivityExpectation{mock: mmGetUserActivity.mock}
+	}
+	mmGetUserActivity.defaultExpectation.results = &ActivityQueryImpMockGetUserActivityResults{apa1, err}
+	mmGetUserActivity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserActivity.mock
+}
+
+// Set uses given function f to mock the ActivityQueryImp.GetUserActivity method
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Set(f func(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) (apa1 []*model.ActivityItem, err error)) *ActivityQueryImpMock {
+	if mmGetUserActivity.defaultExpectation != nil {
+		mmGetUserActivity.mock.t.Fatalf("Default expectation is already set for the ActivityQueryImp.GetUserActivity method")
+	}
+
+	if len(mmGetUserActivity.expectations) > 0 {
+		mmGetUserActivity.mock.t.Fatalf("Some expectations are already set for the ActivityQueryImp.GetUserActivity method")
+	}
+
+	mmGetUserActivity.mock.funcGetUserActivity = f
+	mmGetUserActivity.mock.funcGetUserActivityOrigin = minimock.CallerInfo(1)
+	return mmGetUserActivity.mock
+}
+
+// When sets expectation for the ActivityQueryImp.GetUserActivity which will trigger the result defined by the following
+// Then helper
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) When(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) *ActivityQueryImpMockGetUserActivityExpectation {
+	if mmGetUserActivity.mock.funcGetUserActivity != nil {
+		mmGetUserActivity.mock.t.Fatalf("ActivityQueryImpMock.GetUserActivity mock is already set by Set")
+	}
+
+	expectation := &ActivityQueryImpMockGetUserActivityExpectation{
+		mock:               mmGetUserActivity.mock,
+		params:             &ActivityQueryImpMockGetUserActivityParams{ctx, authorID, after, limit, unpublished},
+		expectationOrigins: ActivityQueryImpMockGetUserActivityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserActivity.expectations = append(mmGetUserActivity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ActivityQueryImp.GetUserActivity return parameters for the expectation previously defined by the When method
+func (e *ActivityQueryImpMockGetUserActivityExpectation) Then(apa1 []*model.ActivityItem, err error) *ActivityQueryImpMock {
+	e.results = &ActivityQueryImpMockGetUserActivityResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times ActivityQueryImp.GetUserActivity should be invoked
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Times(n uint64) *mActivityQueryImpMockGetUserActivity {
+	if n == 0 {
+		mmGetUserActivity.mock.t.Fatalf("Times of ActivityQueryImpMock.GetUserActivity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserActivity.expectedInvocations, n)
+	mmGetUserActivity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserActivity
+}
+
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) invocationsDone() bool {
+	if len(mmGetUserActivity.expectations) == 0 && mmGetUserActivity.defaultExpectation == nil && mmGetUserActivity.mock.funcGetUserActivity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserActivity.mock.afterGetUserActivityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserActivity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserActivity implements ActivityQueryImp
+func (mmGetUserActivity *ActivityQueryImpMock) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) (apa1 []*model.ActivityItem, err error) {
+	mm_atomic.AddUint64(&mmGetUserActivity.beforeGetUserActivityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserActivity.afterGetUserActivityCounter, 1)
+
+	mmGetUserActivity.t.Helper()
+
+	if mmGetUserActivity.inspectFuncGetUserActivity != nil {
+		mmGetUserActivity.inspectFuncGetUserActivity(ctx, authorID, after, limit, unpublished)
+	}
+
+	mm_params := ActivityQueryImpMockGetUserActivityParams{ctx, authorID, after, limit, unpublished}
+
+	// Record call args
+	mmGetUserActivity.GetUserActivityMock.mutex.Lock()
+	mmGetUserActivity.GetUserActivityMock.callArgs = append(mmGetUserActivity.GetUserActivityMock.callArgs, &mm_params)
+	mmGetUserActivity.GetUserActivityMock.mutex.Unlock()
+
+	for _, e := range mmGetUserActivity.GetUserActivityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmGetUserActivity.GetUserActivityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserActivity.GetUserActivityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserActivity.GetUserActivityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserActivity.GetUserActivityMock.defaultExpectation.paramPtrs
+
+		mm_got := ActivityQueryImpMockGetUserActivityParams{ctx, authorID, after, limit, unpublished}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.unpublished != nil && !minimock.Equal(*mm_want_ptrs.unpublished, mm_got.unpublished) {
+				mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameter unpublished, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.originUnpublished, *mm_want_ptrs.unpublished, mm_got.unpublished, minimock.Diff(*mm_want_ptrs.unpublished, mm_got.unpublished))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserActivity.t.Errorf("ActivityQueryImpMock.GetUserActivity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserActivity.GetUserActivityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserActivity.GetUserActivityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserActivity.t.Fatal("No results are set for the ActivityQueryImpMock.GetUserActivity")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmGetUserActivity.funcGetUserActivity != nil {
+		return mmGetUserActivity.funcGetUserActivity(ctx, authorID, after, limit, unpublished)
+	}
+	mmGetUserActivity.t.Fatalf("Unexpected call to ActivityQueryImpMock.GetUserActivity. %v %v %v %v %v", ctx, authorID, after, limit, unpublished)
+	return
+}
+
+// GetUserActivityAfterCounter returns a count of finished ActivityQueryImpMock.GetUserActivity invocations
+func (mmGetUserActivity *ActivityQueryImpMock) GetUserActivityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserActivity.afterGetUserActivityCounter)
+}
+
+// GetUserActivityBeforeCounter returns a count of ActivityQueryImpMock.GetUserActivity invocations
+func (mmGetUserActivity *ActivityQueryImpMock) GetUserActivityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserActivity.beforeGetUserActivityCounter)
+}
+
+// Calls returns a list of arguments used in each call to ActivityQueryImpMock.GetUserActivity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserActivity *mActivityQueryImpMockGetUserActivity) Calls() []*ActivityQueryImpMockGetUserActivityParams {
+	mmGetUserActivity.mutex.RLock()
+
+	argCopy := make([]*ActivityQueryImpMockGetUserActivityParams, len(mmGetUserActivity.callArgs))
+	copy(argCopy, mmGetUserActivity.callArgs)
+
+	mmGetUserActivity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserActivityDone returns true if the count of the GetUserActivity invocations corresponds
+// the number of defined expectations
+func (m *ActivityQueryImpMock) MinimockGetUserActivityDone() bool {
+	if m.GetUserActivityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserActivityMock.invocationsDone()
+}
+
+// MinimockGetUserActivityInspect logs each unmet expectation
+func (m *ActivityQueryImpMock) MinimockGetUserActivityInspect() {
+	for _, e := range m.GetUserActivityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ActivityQueryImpMock.GetUserActivity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserActivityCounter := mm_atomic.LoadUint64(&m.afterGetUserActivityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserActivityMock.defaultExpectation != nil && afterGetUserActivityCounter < 1 {
+		if m.GetUserActivityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ActivityQueryImpMock.GetUserActivity at\n%s", m.GetUserActivityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ActivityQueryImpMock.GetUserActivity at\n%s with params: %#v", m.GetUserActivityMock.defaultExpectation.expectationOrigins.origin, *m.GetUserActivityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserActivity != nil && afterGetUserActivityCounter < 1 {
+		m.t.Errorf("Expected call to ActivityQueryImpMock.GetUserActivity at\n%s", m.funcGetUserActivityOrigin)
+	}
+
+	if !m.GetUserActivityMock.invocationsDone() && afterGetUserActivityCounter > 0 {
+		m.t.Errorf("Expected %d calls to ActivityQueryImpMock.GetUserActivity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserActivityMock.expectedInvocations), m.GetUserActivityMock.expectedInvocationsOrigin, afterGetUserActivityCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ActivityQueryImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserActivityInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ActivityQueryImpMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ActivityQueryImpMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserActivityDone()
+}
@@ -0,0 +1,13 @@
+package activityquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+//go:generate minimock -i ActivityQueryImp
+type ActivityQueryImp interface {
+	GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error)
+}
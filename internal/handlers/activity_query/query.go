@@ -0,0 +1,44 @@
+package activityquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+	"github.com/rs/zerolog/log"
+)
+
+type ActivityQuery struct {
+	activityQueryImp ActivityQueryImp
+}
+
+func NewActivityQuery(activityQueryImp ActivityQueryImp) *ActivityQuery {
+	return &ActivityQuery{activityQueryImp: activityQueryImp}
+}
+
+// GetUserActivity returns up to limit posts and comments of the author, the
+// newest first, starting after the item of the key when it is given. The
+// unpublished posts are included only for the author.
+func (h *ActivityQuery) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int) ([]*model.ActivityItem, error) {
+	op := "internal.handlers.activityquery.GetUserActivity()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if limit <= 0 {
+		return []*model.ActivityItem{}, nil
+	}
+
+	// the author sees their drafts and scheduled posts in the feed
+	viewerID, ok := viewer.FromContext(ctx)
+	unpublished := ok && viewerID == authorID
+
+	items, err := h.activityQueryImp.GetUserActivity(ctx, authorID, after, limit, unpublished)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return items, nil
+}
@@ -0,0 +1,57 @@
+package activityquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserActivity(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	activityQueryImpMock := NewActivityQueryImpMock(mc)
+	handler := ActivityQuery{activityQueryImp: activityQueryImpMock}
+
+	authorID := uuid.New()
+
+	t.Run("Successfully get activity", func(t *testing.T) {
+		ctx := context.Background()
+		after := &model.ActivityKey{CreateDate: time.Now(), Kind: model.ActivityPost, ID: 3}
+		items := []*model.ActivityItem{{Comment: &model.Comment{ID: 1}, PostTitle: "title"}}
+
+		activityQueryImpMock.GetUserActivityMock.Expect(ctx, authorID, after, 6, false).Return(items, nil)
+		got, err := handler.GetUserActivity(ctx, authorID, after, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, items, got)
+	})
+
+	t.Run("The author gets the unpublished posts", func(t *testing.T) {
+		ctx := viewer.NewContext(context.Background(), authorID)
+
+		activityQueryImpMock.GetUserActivityMock.Expect(ctx, authorID, nil, 6, true).Return([]*model.ActivityItem{}, nil)
+		_, err := handler.GetUserActivity(ctx, authorID, nil, 6)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Empty page isn't loaded", func(t *testing.T) {
+		got, err := handler.GetUserActivity(context.Background(), authorID, nil, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("Unexpected error from get activity", func(t *testing.T) {
+		ctx := context.Background()
+
+		activityQueryImpMock.GetUserActivityMock.Expect(ctx, authorID, nil, 6, false).Return(nil, errors.New("unexpected error"))
+		got, err := handler.GetUserActivity(ctx, authorID, nil, 6)
+		assert.NotNil(t, err)
+		assert.Nil(t, got)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
@@ -25,6 +26,13 @@ type CommentMutationImpMock struct {
 	afterAddCommentCounter  uint64
 	beforeAddCommentCounter uint64
 	AddCommentMock          mCommentMutationImpMockAddComment
+
+	funcVoteComment          func(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (cp1 *model.Comment, err error)
+	funcVoteCommentOrigin    string
+	inspectFuncVoteComment   func(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue)
+	afterVoteCommentCounter  uint64
+	beforeVoteCommentCounter uint64
+	VoteCommentMock          mCommentMutationImpMockVoteComment
 }
 
 // NewCommentMutationImpMock returns a mock for CommentMutationImp
@@ -38,6 +46,9 @@ func NewCommentMutationImpMock(t minimock.Tester) *CommentMutationImpMock {
 	m.AddCommentMock = mCommentMutationImpMockAddComment{mock: m}
 	m.AddCommentMock.callArgs = []*CommentMutationImpMockAddCommentParams{}
 
+	m.VoteCommentMock = mCommentMutationImpMockVoteComment{mock: m}
+	m.VoteCommentMock.callArgs = []*CommentMutationImpMockVoteCommentParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -417,11 +428,418 @@ func (m *CommentMutationImpMock) MinimockAddCommentInspect() {
 	}
 }
 
+type mCommentMutationImpMockVoteComment struct {
+	optional           bool
+	mock               *CommentMutationImpMock
+	defaultExpectation *CommentMutationImpMockVoteCommentExpectation
+	expectations       []*CommentMutationImpMockVoteCommentExpectation
+
+	callArgs []*CommentMutationImpMockVoteCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentMutationImpMockVoteCommentExpectation specifies expectation struct of the CommentMutationImp.VoteComment
+type CommentMutationImpMockVoteCommentExpectation struct {
+	mock               *CommentMutationImpMock
+	params             *CommentMutationImpMockVoteCommentParams
+	paramPtrs          *CommentMutationImpMockVoteCommentParamPtrs
+	expectationOrigins CommentMutationImpMockVoteCommentExpectationOrigins
+	results            *CommentMutationImpMockVoteCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentMutationImpMockVoteCommentParams contains parameters of the CommentMutationImp.VoteComment
+type CommentMutationImpMockVoteCommentParams struct {
+	ctx       context.Context
+	commentID int64
+	userID    uuid.UUID
+	value     model.VoteValue
+}
+
+// CommentMutationImpMockVoteCommentParamPtrs contains pointers to parameters of the CommentMutationImp.VoteComment
+type CommentMutationImpMockVoteCommentParamPtrs struct {
+	ctx       *context.Context
+	commentID *int64
+	userID    *uuid.UUID
+	value     *model.VoteValue
+}
+
+// CommentMutationImpMockVoteCommentResults contains results of the CommentMutationImp.VoteComment
+type CommentMutationImpMockVoteCommentResults struct {
+	cp1 *model.Comment
+	err error
+}
+
+// CommentMutationImpMockVoteCommentOrigins contains origins of expectations of the CommentMutationImp.VoteComment
+type CommentMutationImpMockVoteCommentExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCommentID string
+	originUserID    string
+	originValue     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Optional() *mCommentMutationImpMockVoteComment {
+	mmVoteComment.optional = true
+	return mmVoteComment
+}
+
+// Expect sets up expected params for CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Expect(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{}
+	}
+
+	if mmVoteComment.defaultExpectation.paramPtrs != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by ExpectParams functions")
+	}
+
+	mmVoteComment.defaultExpectation.params = &CommentMutationImpMockVoteCommentParams{ctx, commentID, userID, value}
+	mmVoteComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVoteComment.expectations {
+		if minimock.Equal(e.params, mmVoteComment.defaultExpectation.params) {
+			mmVoteComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVoteComment.defaultExpectation.params)
+		}
+	}
+
+	return mmVoteComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) ExpectCtxParam1(ctx context.Context) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{}
+	}
+
+	if mmVoteComment.defaultExpectation.params != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Expect")
+	}
+
+	if mmVoteComment.defaultExpectation.paramPtrs == nil {
+		mmVoteComment.defaultExpectation.paramPtrs = &CommentMutationImpMockVoteCommentParamPtrs{}
+	}
+	mmVoteComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVoteComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVoteComment
+}
+
+// ExpectCommentIDParam2 sets up expected param commentID for CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) ExpectCommentIDParam2(commentID int64) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{}
+	}
+
+	if mmVoteComment.defaultExpectation.params != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Expect")
+	}
+
+	if mmVoteComment.defaultExpectation.paramPtrs == nil {
+		mmVoteComment.defaultExpectation.paramPtrs = &CommentMutationImpMockVoteCommentParamPtrs{}
+	}
+	mmVoteComment.defaultExpectation.paramPtrs.commentID = &commentID
+	mmVoteComment.defaultExpectation.expectationOrigins.originCommentID = minimock.CallerInfo(1)
+
+	return mmVoteComment
+}
+
+// ExpectUserIDParam3 sets up expected param userID for CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) ExpectUserIDParam3(userID uuid.UUID) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{}
+	}
+
+	if mmVoteComment.defaultExpectation.params != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Expect")
+	}
+
+	if mmVoteComment.defaultExpectation.paramPtrs == nil {
+		mmVoteComment.defaultExpectation.paramPtrs = &CommentMutationImpMockVoteCommentParamPtrs{}
+	}
+	mmVoteComment.defaultExpectation.paramPtrs.userID = &userID
+	mmVoteComment.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmVoteComment
+}
+
+// ExpectValueParam4 sets up expected param value for CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) ExpectValueParam4(value model.VoteValue) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{}
+	}
+
+	if mmVoteComment.defaultExpectation.params != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Expect")
+	}
+
+	if mmVoteComment.defaultExpectation.paramPtrs == nil {
+		mmVoteComment.defaultExpectation.paramPtrs = &CommentMutationImpMockVoteCommentParamPtrs{}
+	}
+	mmVoteComment.defaultExpectation.paramPtrs.value = &value
+	mmVoteComment.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmVoteComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Inspect(f func(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue)) *mCommentMutationImpMockVoteComment {
+	if mmVoteComment.mock.inspectFuncVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("Inspect function is already set for CommentMutationImpMock.VoteComment")
+	}
+
+	mmVoteComment.mock.inspectFuncVoteComment = f
+
+	return mmVoteComment
+}
+
+// Return sets up results that will be returned by CommentMutationImp.VoteComment
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Return(cp1 *model.Comment, err error) *CommentMutationImpMock {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	if mmVoteComment.defaultExpectation == nil {
+		mmVoteComment.defaultExpectation = &CommentMutationImpMockVoteCommentExpectation{mock: mmVoteComment.mock}
+	}
+	mmVoteComment.defaultExpectation.results = &CommentMutationImpMockVoteCommentResults{cp1, err}
+	mmVoteComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVoteComment.mock
+}
+
+// Set uses given function f to mock the CommentMutationImp.VoteComment method
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Set(f func(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (cp1 *model.Comment, err error)) *CommentMutationImpMock {
+	if mmVoteComment.defaultExpectation != nil {
+		mmVoteComment.mock.t.Fatalf("Default expectation is already set for the CommentMutationImp.VoteComment method")
+	}
+
+	if len(mmVoteComment.expectations) > 0 {
+		mmVoteComment.mock.t.Fatalf("Some expectations are already set for the CommentMutationImp.VoteComment method")
+	}
+
+	mmVoteComment.mock.funcVoteComment = f
+	mmVoteComment.mock.funcVoteCommentOrigin = minimock.CallerInfo(1)
+	return mmVoteComment.mock
+}
+
+// When sets expectation for the CommentMutationImp.VoteComment which will trigger the result defined by the following
+// Then helper
+func (mmVoteComment *mCommentMutationImpMockVoteComment) When(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) *CommentMutationImpMockVoteCommentExpectation {
+	if mmVoteComment.mock.funcVoteComment != nil {
+		mmVoteComment.mock.t.Fatalf("CommentMutationImpMock.VoteComment mock is already set by Set")
+	}
+
+	expectation := &CommentMutationImpMockVoteCommentExpectation{
+		mock:               mmVoteComment.mock,
+		params:             &CommentMutationImpMockVoteCommentParams{ctx, commentID, userID, value},
+		expectationOrigins: CommentMutationImpMockVoteCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVoteComment.expectations = append(mmVoteComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentMutationImp.VoteComment return parameters for the expectation previously defined by the When method
+func (e *CommentMutationImpMockVoteCommentExpectation) Then(cp1 *model.Comment, err error) *CommentMutationImpMock {
+	e.results = &CommentMutationImpMockVoteCommentResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentMutationImp.VoteComment should be invoked
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Times(n uint64) *mCommentMutationImpMockVoteComment {
+	if n == 0 {
+		mmVoteComment.mock.t.Fatalf("Times of CommentMutationImpMock.VoteComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVoteComment.expectedInvocations, n)
+	mmVoteComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVoteComment
+}
+
+func (mmVoteComment *mCommentMutationImpMockVoteComment) invocationsDone() bool {
+	if len(mmVoteComment.expectations) == 0 && mmVoteComment.defaultExpectation == nil && mmVoteComment.mock.funcVoteComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVoteComment.mock.afterVoteCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVoteComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VoteComment implements CommentMutationImp
+func (mmVoteComment *CommentMutationImpMock) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (cp1 *model.Comment, err error) {
+	mm_atomic.AddUint64(&mmVoteComment.beforeVoteCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmVoteComment.afterVoteCommentCounter, 1)
+
+	mmVoteComment.t.Helper()
+
+	if mmVoteComment.inspectFuncVoteComment != nil {
+		mmVoteComment.inspectFuncVoteComment(ctx, commentID, userID, value)
+	}
+
+	mm_params := CommentMutationImpMockVoteCommentParams{ctx, commentID, userID, value}
+
+	// Record call args
+	mmVoteComment.VoteCommentMock.mutex.Lock()
+	mmVoteComment.VoteCommentMock.callArgs = append(mmVoteComment.VoteCommentMock.callArgs, &mm_params)
+	mmVoteComment.VoteCommentMock.mutex.Unlock()
+
+	for _, e := range mmVoteComment.VoteCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmVoteComment.VoteCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVoteComment.VoteCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmVoteComment.VoteCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmVoteComment.VoteCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentMutationImpMockVoteCommentParams{ctx, commentID, userID, value}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVoteComment.t.Errorf("CommentMutationImpMock.VoteComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVoteComment.VoteCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.commentID != nil && !minimock.Equal(*mm_want_ptrs.commentID, mm_got.commentID) {
+				mmVoteComment.t.Errorf("CommentMutationImpMock.VoteComment got unexpected parameter commentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVoteComment.VoteCommentMock.defaultExpectation.expectationOrigins.originCommentID, *mm_want_ptrs.commentID, mm_got.commentID, minimock.Diff(*mm_want_ptrs.commentID, mm_got.commentID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmVoteComment.t.Errorf("CommentMutationImpMock.VoteComment got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVoteComment.VoteCommentMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmVoteComment.t.Errorf("CommentMutationImpMock.VoteComment got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVoteComment.VoteCommentMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVoteComment.t.Errorf("CommentMutationImpMock.VoteComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVoteComment.VoteCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVoteComment.VoteCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVoteComment.t.Fatal("No results are set for the CommentMutationImpMock.VoteComment")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmVoteComment.funcVoteComment != nil {
+		return mmVoteComment.funcVoteComment(ctx, commentID, userID, value)
+	}
+	mmVoteComment.t.Fatalf("Unexpected call to CommentMutationImpMock.VoteComment. %v %v %v %v", ctx, commentID, userID, value)
+	return
+}
+
+// VoteCommentAfterCounter returns a count of finished CommentMutationImpMock.VoteComment invocations
+func (mmVoteComment *CommentMutationImpMock) VoteCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVoteComment.afterVoteCommentCounter)
+}
+
+// VoteCommentBeforeCounter returns a count of CommentMutationImpMock.VoteComment invocations
+func (mmVoteComment *CommentMutationImpMock) VoteCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVoteComment.beforeVoteCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentMutationImpMock.VoteComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVoteComment *mCommentMutationImpMockVoteComment) Calls() []*CommentMutationImpMockVoteCommentParams {
+	mmVoteComment.mutex.RLock()
+
+	argCopy := make([]*CommentMutationImpMockVoteCommentParams, len(mmVoteComment.callArgs))
+	copy(argCopy, mmVoteComment.callArgs)
+
+	mmVoteComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVoteCommentDone returns true if the count of the VoteComment invocations corresponds
+// the number of defined expectations
+func (m *CommentMutationImpMock) MinimockVoteCommentDone() bool {
+	if m.VoteCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VoteCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VoteCommentMock.invocationsDone()
+}
+
+// MinimockVoteCommentInspect logs each unmet expectation
+func (m *CommentMutationImpMock) MinimockVoteCommentInspect() {
+	for _, e := range m.VoteCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentMutationImpMock.VoteComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVoteCommentCounter := mm_atomic.LoadUint64(&m.afterVoteCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VoteCommentMock.defaultExpectation != nil && afterVoteCommentCounter < 1 {
+		if m.VoteCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentMutationImpMock.VoteComment at\n%s", m.VoteCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentMutationImpMock.VoteComment at\n%s with params: %#v", m.VoteCommentMock.defaultExpectation.expectationOrigins.origin, *m.VoteCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVoteComment != nil && afterVoteCommentCounter < 1 {
+		m.t.Errorf("Expected call to CommentMutationImpMock.VoteComment at\n%s", m.funcVoteCommentOrigin)
+	}
+
+	if !m.VoteCommentMock.invocationsDone() && afterVoteCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentMutationImpMock.VoteComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VoteCommentMock.expectedInvocations), m.VoteCommentMock.expectedInvocationsOrigin, afterVoteCommentCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *CommentMutationImpMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockAddCommentInspect()
+
+			m.MinimockVoteCommentInspect()
 		}
 	})
 }
@@ -445,5 +863,6 @@ func (m *CommentMutationImpMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CommentMutationImpMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockAddCommentDone()
+		m.MinimockAddCommentDone() &&
+		m.MinimockVoteCommentDone()
 }
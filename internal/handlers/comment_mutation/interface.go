@@ -3,10 +3,12 @@ package commentmutation
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
 //go:generate minimock -i CommentMutationImp
 type CommentMutationImp interface {
 	AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error)
+	VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error)
 }
@@ -6,24 +6,34 @@ import (
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
 	"github.com/stretchr/testify/assert"
 )
 
+var testLimits = validate.Limits{
+	TitleMaxLength:       200,
+	PostTextMaxLength:    50000,
+	CommentTextMaxLength: 2000,
+}
+
 func TestAddComment(t *testing.T) {
 
 	mc := minimock.NewController(t)
 
 	commentMutationImpMock := NewCommentMutationImpMock(mc)
 
-	handler := CommentMutation{commentMutationImp: commentMutationImpMock}
+	handler := CommentMutation{commentMutationImp: commentMutationImpMock, limits: testLimits}
+	authorID := uuid.New()
 
 	t.Run("Successfully add comment", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: "Correct comment",
+			AuthorID: authorID,
+			Text:     "Correct comment",
 		}
 
 		expectedComment := &model.Comment{
@@ -39,15 +49,42 @@ func TestAddComment(t *testing.T) {
 		assert.Equal(t, expectedComment, comment)
 	})
 
+	t.Run("Text is trimmed", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(1)
+		newComment := &model.NewComment{
+			AuthorID: authorID,
+			Text:     "\n\nCorrect comment  \n",
+		}
+		trimmed := &model.NewComment{
+			AuthorID: authorID,
+			Text:     "Correct comment",
+		}
+
+		expectedComment := &model.Comment{
+			ID:   1,
+			Text: trimmed.Text,
+		}
+
+		commentMutationImpMock.AddCommentMock.Expect(ctx, postID, trimmed).Return(expectedComment, nil)
+
+		comment, err := handler.AddComment(ctx, postID, newComment)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedComment, comment)
+	})
+
 	t.Run("Error comment long", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: strings.Repeat("f", 2001),
+			AuthorID: authorID,
+			Text:     strings.Repeat("ф", 2001),
 		}
 
 		comment, err := handler.AddComment(ctx, postID, newComment)
-		assert.Equal(t, errs.ErrIncorrectCommentLength, err)
+		assert.Equal(t, &errs.ValidationError{Fields: []errs.FieldError{
+			{Field: "text", Message: "must be at most 2000 characters"},
+		}}, err)
 		assert.Nil(t, comment)
 
 	})
@@ -56,11 +93,28 @@ func TestAddComment(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: "",
+			AuthorID: authorID,
+			Text:     " \n ",
 		}
 
 		comment, err := handler.AddComment(ctx, postID, newComment)
-		assert.Equal(t, errs.ErrIncorrectCommentLength, err)
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		assert.Nil(t, comment)
+
+	})
+
+	t.Run("Error invalid fields", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(1)
+		newComment := &model.NewComment{
+			Text: "bad\x00text",
+		}
+
+		comment, err := handler.AddComment(ctx, postID, newComment)
+		assert.Equal(t, &errs.ValidationError{Fields: []errs.FieldError{
+			{Field: "authorID", Message: "must not be nil UUID"},
+			{Field: "text", Message: "must not contain control characters"},
+		}}, err)
 		assert.Nil(t, comment)
 
 	})
@@ -69,7 +123,8 @@ func TestAddComment(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: "Correct comment",
+			AuthorID: authorID,
+			Text:     "Correct comment",
 		}
 
 		commentMutationImpMock.AddCommentMock.Expect(ctx, postID, newComment).Return(nil, errs.ErrPostNotExist)
@@ -84,7 +139,8 @@ func TestAddComment(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: "Correct comment",
+			AuthorID: authorID,
+			Text:     "Correct comment",
 		}
 
 		commentMutationImpMock.AddCommentMock.Expect(ctx, postID, newComment).Return(nil, errs.ErrParentCommentNotExist)
@@ -95,11 +151,36 @@ func TestAddComment(t *testing.T) {
 
 	})
 
+	t.Run("Global depth limit is passed to the storage", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(1)
+		depth := model.CommentDepth{Max: 3, Mode: model.DepthReject}
+		handler := CommentMutation{commentMutationImp: commentMutationImpMock, limits: testLimits, depth: depth}
+		parentID := int64(4)
+		newComment := &model.NewComment{
+			AuthorID: authorID,
+			ParentID: &parentID,
+			Text:     "Correct comment",
+		}
+
+		commentMutationImpMock.AddCommentMock.Expect(ctx, postID, &model.NewComment{
+			AuthorID: authorID,
+			ParentID: &parentID,
+			Text:     "Correct comment",
+			Depth:    depth,
+		}).Return(nil, errs.ErrCommentTooDeep)
+
+		comment, err := handler.AddComment(ctx, postID, newComment)
+		assert.Equal(t, errs.ErrCommentTooDeep, err)
+		assert.Nil(t, comment)
+	})
+
 	t.Run("Errorcomment not enabled", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
 		newComment := &model.NewComment{
-			Text: "Correct comment",
+			AuthorID: authorID,
+			Text:     "Correct comment",
 		}
 
 		commentMutationImpMock.AddCommentMock.Expect(ctx, postID, newComment).Return(nil, errs.ErrCommentsNotEnabled)
@@ -111,3 +192,37 @@ func TestAddComment(t *testing.T) {
 	})
 
 }
+
+func TestVoteComment(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	commentMutationImpMock := NewCommentMutationImpMock(mc)
+	handler := CommentMutation{commentMutationImp: commentMutationImpMock}
+
+	t.Run("Successfully vote comment", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedComment := &model.Comment{ID: 1, Score: 1}
+
+		commentMutationImpMock.VoteCommentMock.Expect(ctx, int64(1), userID, model.VoteUp).Return(expectedComment, nil)
+		comment, err := handler.VoteComment(ctx, 1, userID, model.VoteUp)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedComment, comment)
+	})
+
+	t.Run("Error invalid vote value", func(t *testing.T) {
+		comment, err := handler.VoteComment(context.Background(), 1, uuid.New(), model.VoteValue(-2))
+		assert.Equal(t, errs.ErrInvalidVote, err)
+		assert.Nil(t, comment)
+	})
+
+	t.Run("Error comment not exist", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+
+		commentMutationImpMock.VoteCommentMock.Expect(ctx, int64(123), userID, model.VoteDown).Return(nil, errs.ErrCommentNotExist)
+		comment, err := handler.VoteComment(ctx, 123, userID, model.VoteDown)
+		assert.Equal(t, errs.ErrCommentNotExist, err)
+		assert.Nil(t, comment)
+	})
+}
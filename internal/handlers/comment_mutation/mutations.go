@@ -4,36 +4,71 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
 	"github.com/rs/zerolog/log"
 )
 
 type CommentMutation struct {
 	commentMutationImp CommentMutationImp
+	limits             validate.Limits
+	depth              model.CommentDepth
 }
 
-func NewCommentMutation(commentMutationImp CommentMutationImp) *CommentMutation {
-	return &CommentMutation{commentMutationImp: commentMutationImp}
+// NewCommentMutation returns the handler checking the texts against the limits,
+// depth is the nesting limit of the posts without their own.
+func NewCommentMutation(commentMutationImp CommentMutationImp, limits validate.Limits, depth model.CommentDepth) *CommentMutation {
+	return &CommentMutation{commentMutationImp: commentMutationImp, limits: limits, depth: depth}
 }
 
 func (h *CommentMutation) AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error) {
 	op := "internal.handlers.commentmutation.AddComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	if len(newComment.Text) > 2000 || len(newComment.Text) <= 0 {
-		return nil, errs.ErrIncorrectCommentLength
+	normalized := *newComment
+	var v validate.Validator
+	v.Author("authorID", newComment.AuthorID)
+	normalized.Text = v.Text("text", newComment.Text, h.limits.CommentTextMaxLength)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
+	normalized.Depth = h.depth
 
-	comment, err := h.commentMutationImp.AddComment(ctx, postID, newComment)
+	comment, err := h.commentMutationImp.AddComment(ctx, postID, &normalized)
 	if err != nil {
-		if err == errs.ErrPostNotExist || err == errs.ErrParentCommentNotExist || err == errs.ErrCommentsNotEnabled {
+		if err == errs.ErrPostNotExist || err == errs.ErrParentCommentNotExist || err == errs.ErrCommentsNotEnabled || err == errs.ErrCommentTooDeep {
 			return nil, err
 		}
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return comment, nil
+}
+
+func (h *CommentMutation) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	op := "internal.handlers.commentmutation.VoteComment()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(userID); err != nil {
+		return nil, err
+	}
+	if value < model.VoteDown || value > model.VoteUp {
+		return nil, errs.ErrInvalidVote
+	}
+
+	comment, err := h.commentMutationImp.VoteComment(ctx, commentID, userID, value)
+	if err != nil {
+		if err == errs.ErrCommentNotExist {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return comment, nil
 }
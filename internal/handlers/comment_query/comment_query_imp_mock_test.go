@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
@@ -26,12 +27,33 @@ type CommentQueryImpMock struct {
 	beforeGetCommentPathCounter uint64
 	GetCommentPathMock          mCommentQueryImpMockGetCommentPath
 
+	funcGetCommentPaths          func(ctx context.Context, commentIDs []int64) (m1 map[int64]string, err error)
+	funcGetCommentPathsOrigin    string
+	inspectFuncGetCommentPaths   func(ctx context.Context, commentIDs []int64)
+	afterGetCommentPathsCounter  uint64
+	beforeGetCommentPathsCounter uint64
+	GetCommentPathsMock          mCommentQueryImpMockGetCommentPaths
+
+	funcGetCommentVotes          func(ctx context.Context, userID uuid.UUID, commentIDs []int64) (m1 map[int64]model.VoteValue, err error)
+	funcGetCommentVotesOrigin    string
+	inspectFuncGetCommentVotes   func(ctx context.Context, userID uuid.UUID, commentIDs []int64)
+	afterGetCommentVotesCounter  uint64
+	beforeGetCommentVotesCounter uint64
+	GetCommentVotesMock          mCommentQueryImpMockGetCommentVotes
+
 	funcGetCommentsBranch          func(ctx context.Context, postID int64, path string) (cpa1 []*model.Comment, err error)
 	funcGetCommentsBranchOrigin    string
 	inspectFuncGetCommentsBranch   func(ctx context.Context, postID int64, path string)
 	afterGetCommentsBranchCounter  uint64
 	beforeGetCommentsBranchCounter uint64
 	GetCommentsBranchMock          mCommentQueryImpMockGetCommentsBranch
+
+	funcGetCommentsBranches          func(ctx context.Context, keys []model.BranchKey) (m1 map[model.BranchKey][]*model.Comment, err error)
+	funcGetCommentsBranchesOrigin    string
+	inspectFuncGetCommentsBranches   func(ctx context.Context, keys []model.BranchKey)
+	afterGetCommentsBranchesCounter  uint64
+	beforeGetCommentsBranchesCounter uint64
+	GetCommentsBranchesMock          mCommentQueryImpMockGetCommentsBranches
 }
 
 // NewCommentQueryImpMock returns a mock for CommentQueryImp
@@ -45,9 +67,18 @@ func NewCommentQueryImpMock(t minimock.Tester) *CommentQueryImpMock {
 	m.GetCommentPathMock = mCommentQueryImpMockGetCommentPath{mock: m}
 	m.GetCommentPathMock.callArgs = []*CommentQueryImpMockGetCommentPathParams{}
 
+	m.GetCommentPathsMock = mCommentQueryImpMockGetCommentPaths{mock: m}
+	m.GetCommentPathsMock.callArgs = []*CommentQueryImpMockGetCommentPathsParams{}
+
+	m.GetCommentVotesMock = mCommentQueryImpMockGetCommentVotes{mock: m}
+	m.GetCommentVotesMock.callArgs = []*CommentQueryImpMockGetCommentVotesParams{}
+
 	m.GetCommentsBranchMock = mCommentQueryImpMockGetCommentsBranch{mock: m}
 	m.GetCommentsBranchMock.callArgs = []*CommentQueryImpMockGetCommentsBranchParams{}
 
+	m.GetCommentsBranchesMock = mCommentQueryImpMockGetCommentsBranches{mock: m}
+	m.GetCommentsBranchesMock.callArgs = []*CommentQueryImpMockGetCommentsBranchesParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -396,56 +427,53 @@ func (m *CommentQueryImpMock) MinimockGetCommentPathInspect() {
 	}
 }
 
-type mCommentQueryImpMockGetCommentsBranch struct {
+type mCommentQueryImpMockGetCommentPaths struct {
 	optional           bool
 	mock               *CommentQueryImpMock
-	defaultExpectation *CommentQueryImpMockGetCommentsBranchExpectation
-	expectations       []*CommentQueryImpMockGetCommentsBranchExpectation
+	defaultExpectation *CommentQueryImpMockGetCommentPathsExpectation
+	expectations       []*CommentQueryImpMockGetCommentPathsExpectation
 
-	callArgs []*CommentQueryImpMockGetCommentsBranchParams
+	callArgs []*CommentQueryImpMockGetCommentPathsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CommentQueryImpMockGetCommentsBranchExpectation specifies expectation struct of the CommentQueryImp.GetCommentsBranch
-type CommentQueryImpMockGetCommentsBranchExpectation struct {
+// CommentQueryImpMockGetCommentPathsExpectation specifies expectation struct of the CommentQueryImp.GetCommentPaths
+type CommentQueryImpMockGetCommentPathsExpectation struct {
 	mock               *CommentQueryImpMock
-	params             *CommentQueryImpMockGetCommentsBranchParams
-	paramPtrs          *CommentQueryImpMockGetCommentsBranchParamPtrs
-	expectationOrigins CommentQueryImpMockGetCommentsBranchExpectationOrigins
-	results            *CommentQueryImpMockGetCommentsBranchResults
+	params             *CommentQueryImpMockGetCommentPathsParams
+	paramPtrs          *CommentQueryImpMockGetCommentPathsParamPtrs
+	expectationOrigins CommentQueryImpMockGetCommentPathsExpectationOrigins
+	results            *CommentQueryImpMockGetCommentPathsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CommentQueryImpMockGetCommentsBranchParams contains parameters of the CommentQueryImp.GetCommentsBranch
-type CommentQueryImpMockGetCommentsBranchParams struct {
-	ctx    context.Context
-	postID int64
-	path   string
+// CommentQueryImpMockGetCommentPathsParams contains parameters of the CommentQueryImp.GetCommentPaths
+type CommentQueryImpMockGetCommentPathsParams struct {
+	ctx        context.Context
+	commentIDs []int64
 }
 
-// CommentQueryImpMockGetCommentsBranchParamPtrs contains pointers to parameters of the CommentQueryImp.GetCommentsBranch
-type CommentQueryImpMockGetCommentsBranchParamPtrs struct {
-	ctx    *context.Context
-	postID *int64
-	path   *string
+// CommentQueryImpMockGetCommentPathsParamPtrs contains pointers to parameters of the CommentQueryImp.GetCommentPaths
+type CommentQueryImpMockGetCommentPathsParamPtrs struct {
+	ctx        *context.Context
+	commentIDs *[]int64
 }
 
-// CommentQueryImpMockGetCommentsBranchResults contains results of the CommentQueryImp.GetCommentsBranch
-type CommentQueryImpMockGetCommentsBranchResults struct {
-	cpa1 []*model.Comment
-	err  error
+// CommentQueryImpMockGetCommentPathsResults contains results of the CommentQueryImp.GetCommentPaths
+type CommentQueryImpMockGetCommentPathsResults struct {
+	m1  map[int64]string
+	err error
 }
 
-// CommentQueryImpMockGetCommentsBranchOrigins contains origins of expectations of the CommentQueryImp.GetCommentsBranch
-type CommentQueryImpMockGetCommentsBranchExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originPostID string
-	originPath   string
+// CommentQueryImpMockGetCommentPathsOrigins contains origins of expectations of the CommentQueryImp.GetCommentPaths
+type CommentQueryImpMockGetCommentPathsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCommentIDs string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -453,330 +481,1399 @@ type CommentQueryImpMockGetCommentsBranchExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Optional() *mCommentQueryImpMockGetCommentsBranch {
-	mmGetCommentsBranch.optional = true
-	return mmGetCommentsBranch
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Optional() *mCommentQueryImpMockGetCommentPaths {
+	mmGetCommentPaths.optional = true
+	return mmGetCommentPaths
 }
 
-// Expect sets up expected params for CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Expect(ctx context.Context, postID int64, path string) *mCommentQueryImpMockGetCommentsBranch {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+// Expect sets up expected params for CommentQueryImp.GetCommentPaths
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Expect(ctx context.Context, commentIDs []int64) *mCommentQueryImpMockGetCommentPaths {
+	if mmGetCommentPaths.mock.funcGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Set")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation == nil {
-		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	if mmGetCommentPaths.defaultExpectation == nil {
+		mmGetCommentPaths.defaultExpectation = &CommentQueryImpMockGetCommentPathsExpectation{}
 	}
 
-	if mmGetCommentsBranch.defaultExpectation.paramPtrs != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by ExpectParams functions")
+	if mmGetCommentPaths.defaultExpectation.paramPtrs != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by ExpectParams functions")
 	}
 
-	mmGetCommentsBranch.defaultExpectation.params = &CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
-	mmGetCommentsBranch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCommentsBranch.expectations {
-		if minimock.Equal(e.params, mmGetCommentsBranch.defaultExpectation.params) {
-			mmGetCommentsBranch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsBranch.defaultExpectation.params)
+	mmGetCommentPaths.defaultExpectation.params = &CommentQueryImpMockGetCommentPathsParams{ctx, commentIDs}
+	mmGetCommentPaths.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentPaths.expectations {
+		if minimock.Equal(e.params, mmGetCommentPaths.defaultExpectation.params) {
+			mmGetCommentPaths.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentPaths.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCommentsBranch
-}
-
-// ExpectCtxParam1 sets up expected param ctx for CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectCtxParam1(ctx context.Context) *mCommentQueryImpMockGetCommentsBranch {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
-	}
-
-	if mmGetCommentsBranch.defaultExpectation == nil {
-		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
-	}
-
-	if mmGetCommentsBranch.defaultExpectation.params != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
-	}
-
-	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
-	}
-	mmGetCommentsBranch.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetCommentsBranch
+	return mmGetCommentPaths
 }
 
-// ExpectPostIDParam2 sets up expected param postID for CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectPostIDParam2(postID int64) *mCommentQueryImpMockGetCommentsBranch {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CommentQueryImp.GetCommentPaths
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) ExpectCtxParam1(ctx context.Context) *mCommentQueryImpMockGetCommentPaths {
+	if mmGetCommentPaths.mock.funcGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Set")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation == nil {
-		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	if mmGetCommentPaths.defaultExpectation == nil {
+		mmGetCommentPaths.defaultExpectation = &CommentQueryImpMockGetCommentPathsExpectation{}
 	}
 
-	if mmGetCommentsBranch.defaultExpectation.params != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
+	if mmGetCommentPaths.defaultExpectation.params != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Expect")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
+	if mmGetCommentPaths.defaultExpectation.paramPtrs == nil {
+		mmGetCommentPaths.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentPathsParamPtrs{}
 	}
-	mmGetCommentsBranch.defaultExpectation.paramPtrs.postID = &postID
-	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+	mmGetCommentPaths.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentPaths.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCommentsBranch
+	return mmGetCommentPaths
 }
 
-// ExpectPathParam3 sets up expected param path for CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectPathParam3(path string) *mCommentQueryImpMockGetCommentsBranch {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+// ExpectCommentIDsParam2 sets up expected param commentIDs for CommentQueryImp.GetCommentPaths
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) ExpectCommentIDsParam2(commentIDs []int64) *mCommentQueryImpMockGetCommentPaths {
+	if mmGetCommentPaths.mock.funcGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Set")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation == nil {
-		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	if mmGetCommentPaths.defaultExpectation == nil {
+		mmGetCommentPaths.defaultExpectation = &CommentQueryImpMockGetCommentPathsExpectation{}
 	}
 
-	if mmGetCommentsBranch.defaultExpectation.params != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
+	if mmGetCommentPaths.defaultExpectation.params != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Expect")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
+	if mmGetCommentPaths.defaultExpectation.paramPtrs == nil {
+		mmGetCommentPaths.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentPathsParamPtrs{}
 	}
-	mmGetCommentsBranch.defaultExpectation.paramPtrs.path = &path
-	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originPath = minimock.CallerInfo(1)
+	mmGetCommentPaths.defaultExpectation.paramPtrs.commentIDs = &commentIDs
+	mmGetCommentPaths.defaultExpectation.expectationOrigins.originCommentIDs = minimock.CallerInfo(1)
 
-	return mmGetCommentsBranch
+	return mmGetCommentPaths
 }
 
-// Inspect accepts an inspector function that has same arguments as the CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Inspect(f func(ctx context.Context, postID int64, path string)) *mCommentQueryImpMockGetCommentsBranch {
-	if mmGetCommentsBranch.mock.inspectFuncGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("Inspect function is already set for CommentQueryImpMock.GetCommentsBranch")
+// Inspect accepts an inspector function that has same arguments as the CommentQueryImp.GetCommentPaths
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Inspect(f func(ctx context.Context, commentIDs []int64)) *mCommentQueryImpMockGetCommentPaths {
+	if mmGetCommentPaths.mock.inspectFuncGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("Inspect function is already set for CommentQueryImpMock.GetCommentPaths")
 	}
 
-	mmGetCommentsBranch.mock.inspectFuncGetCommentsBranch = f
+	mmGetCommentPaths.mock.inspectFuncGetCommentPaths = f
 
-	return mmGetCommentsBranch
+	return mmGetCommentPaths
 }
 
-// Return sets up results that will be returned by CommentQueryImp.GetCommentsBranch
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Return(cpa1 []*model.Comment, err error) *CommentQueryImpMock {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+// Return sets up results that will be returned by CommentQueryImp.GetCommentPaths
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Return(m1 map[int64]string, err error) *CommentQueryImpMock {
+	if mmGetCommentPaths.mock.funcGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Set")
 	}
 
-	if mmGetCommentsBranch.defaultExpectation == nil {
-		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{mock: mmGetCommentsBranch.mock}
+	if mmGetCommentPaths.defaultExpectation == nil {
+		mmGetCommentPaths.defaultExpectation = &CommentQueryImpMockGetCommentPathsExpectation{mock: mmGetCommentPaths.mock}
 	}
-	mmGetCommentsBranch.defaultExpectation.results = &CommentQueryImpMockGetCommentsBranchResults{cpa1, err}
-	mmGetCommentsBranch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsBranch.mock
+	mmGetCommentPaths.defaultExpectation.results = &CommentQueryImpMockGetCommentPathsResults{m1, err}
+	mmGetCommentPaths.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentPaths.mock
 }
 
-// Set uses given function f to mock the CommentQueryImp.GetCommentsBranch method
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Set(f func(ctx context.Context, postID int64, path string) (cpa1 []*model.Comment, err error)) *CommentQueryImpMock {
-	if mmGetCommentsBranch.defaultExpectation != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("Default expectation is already set for the CommentQueryImp.GetCommentsBranch method")
+// Set uses given function f to mock the CommentQueryImp.GetCommentPaths method
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Set(f func(ctx context.Context, commentIDs []int64) (m1 map[int64]string, err error)) *CommentQueryImpMock {
+	if mmGetCommentPaths.defaultExpectation != nil {
+		mmGetCommentPaths.mock.t.Fatalf("Default expectation is already set for the CommentQueryImp.GetCommentPaths method")
 	}
 
-	if len(mmGetCommentsBranch.expectations) > 0 {
-		mmGetCommentsBranch.mock.t.Fatalf("Some expectations are already set for the CommentQueryImp.GetCommentsBranch method")
+	if len(mmGetCommentPaths.expectations) > 0 {
+		mmGetCommentPaths.mock.t.Fatalf("Some expectations are already set for the CommentQueryImp.GetCommentPaths method")
 	}
 
-	mmGetCommentsBranch.mock.funcGetCommentsBranch = f
-	mmGetCommentsBranch.mock.funcGetCommentsBranchOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsBranch.mock
+	mmGetCommentPaths.mock.funcGetCommentPaths = f
+	mmGetCommentPaths.mock.funcGetCommentPathsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentPaths.mock
 }
 
-// When sets expectation for the CommentQueryImp.GetCommentsBranch which will trigger the result defined by the following
+// When sets expectation for the CommentQueryImp.GetCommentPaths which will trigger the result defined by the following
 // Then helper
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) When(ctx context.Context, postID int64, path string) *CommentQueryImpMockGetCommentsBranchExpectation {
-	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
-		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) When(ctx context.Context, commentIDs []int64) *CommentQueryImpMockGetCommentPathsExpectation {
+	if mmGetCommentPaths.mock.funcGetCommentPaths != nil {
+		mmGetCommentPaths.mock.t.Fatalf("CommentQueryImpMock.GetCommentPaths mock is already set by Set")
 	}
 
-	expectation := &CommentQueryImpMockGetCommentsBranchExpectation{
-		mock:               mmGetCommentsBranch.mock,
-		params:             &CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path},
-		expectationOrigins: CommentQueryImpMockGetCommentsBranchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &CommentQueryImpMockGetCommentPathsExpectation{
+		mock:               mmGetCommentPaths.mock,
+		params:             &CommentQueryImpMockGetCommentPathsParams{ctx, commentIDs},
+		expectationOrigins: CommentQueryImpMockGetCommentPathsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetCommentsBranch.expectations = append(mmGetCommentsBranch.expectations, expectation)
+	mmGetCommentPaths.expectations = append(mmGetCommentPaths.expectations, expectation)
 	return expectation
 }
 
-// Then sets up CommentQueryImp.GetCommentsBranch return parameters for the expectation previously defined by the When method
-func (e *CommentQueryImpMockGetCommentsBranchExpectation) Then(cpa1 []*model.Comment, err error) *CommentQueryImpMock {
-	e.results = &CommentQueryImpMockGetCommentsBranchResults{cpa1, err}
+// Then sets up CommentQueryImp.GetCommentPaths return parameters for the expectation previously defined by the When method
+func (e *CommentQueryImpMockGetCommentPathsExpectation) Then(m1 map[int64]string, err error) *CommentQueryImpMock {
+	e.results = &CommentQueryImpMockGetCommentPathsResults{m1, err}
 	return e.mock
 }
 
-// Times sets number of times CommentQueryImp.GetCommentsBranch should be invoked
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Times(n uint64) *mCommentQueryImpMockGetCommentsBranch {
+// Times sets number of times CommentQueryImp.GetCommentPaths should be invoked
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Times(n uint64) *mCommentQueryImpMockGetCommentPaths {
 	if n == 0 {
-		mmGetCommentsBranch.mock.t.Fatalf("Times of CommentQueryImpMock.GetCommentsBranch mock can not be zero")
+		mmGetCommentPaths.mock.t.Fatalf("Times of CommentQueryImpMock.GetCommentPaths mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetCommentsBranch.expectedInvocations, n)
-	mmGetCommentsBranch.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsBranch
+	mm_atomic.StoreUint64(&mmGetCommentPaths.expectedInvocations, n)
+	mmGetCommentPaths.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentPaths
 }
 
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) invocationsDone() bool {
-	if len(mmGetCommentsBranch.expectations) == 0 && mmGetCommentsBranch.defaultExpectation == nil && mmGetCommentsBranch.mock.funcGetCommentsBranch == nil {
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) invocationsDone() bool {
+	if len(mmGetCommentPaths.expectations) == 0 && mmGetCommentPaths.defaultExpectation == nil && mmGetCommentPaths.mock.funcGetCommentPaths == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranch.mock.afterGetCommentsBranchCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranch.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentPaths.mock.afterGetCommentPathsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentPaths.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetCommentsBranch implements CommentQueryImp
-func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranch(ctx context.Context, postID int64, path string) (cpa1 []*model.Comment, err error) {
-	mm_atomic.AddUint64(&mmGetCommentsBranch.beforeGetCommentsBranchCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCommentsBranch.afterGetCommentsBranchCounter, 1)
+// GetCommentPaths implements CommentQueryImp
+func (mmGetCommentPaths *CommentQueryImpMock) GetCommentPaths(ctx context.Context, commentIDs []int64) (m1 map[int64]string, err error) {
+	mm_atomic.AddUint64(&mmGetCommentPaths.beforeGetCommentPathsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentPaths.afterGetCommentPathsCounter, 1)
 
-	mmGetCommentsBranch.t.Helper()
+	mmGetCommentPaths.t.Helper()
 
-	if mmGetCommentsBranch.inspectFuncGetCommentsBranch != nil {
-		mmGetCommentsBranch.inspectFuncGetCommentsBranch(ctx, postID, path)
+	if mmGetCommentPaths.inspectFuncGetCommentPaths != nil {
+		mmGetCommentPaths.inspectFuncGetCommentPaths(ctx, commentIDs)
 	}
 
-	mm_params := CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
+	mm_params := CommentQueryImpMockGetCommentPathsParams{ctx, commentIDs}
 
 	// Record call args
-	mmGetCommentsBranch.GetCommentsBranchMock.mutex.Lock()
-	mmGetCommentsBranch.GetCommentsBranchMock.callArgs = append(mmGetCommentsBranch.GetCommentsBranchMock.callArgs, &mm_params)
-	mmGetCommentsBranch.GetCommentsBranchMock.mutex.Unlock()
+	mmGetCommentPaths.GetCommentPathsMock.mutex.Lock()
+	mmGetCommentPaths.GetCommentPathsMock.callArgs = append(mmGetCommentPaths.GetCommentPathsMock.callArgs, &mm_params)
+	mmGetCommentPaths.GetCommentPathsMock.mutex.Unlock()
 
-	for _, e := range mmGetCommentsBranch.GetCommentsBranchMock.expectations {
+	for _, e := range mmGetCommentPaths.GetCommentPathsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cpa1, e.results.err
+			return e.results.m1, e.results.err
 		}
 	}
 
-	if mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.paramPtrs
+	if mmGetCommentPaths.GetCommentPathsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.paramPtrs
 
-		mm_got := CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
+		mm_got := CommentQueryImpMockGetCommentPathsParams{ctx, commentIDs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
-				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+				mmGetCommentPaths.t.Errorf("CommentQueryImpMock.GetCommentPaths got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.path != nil && !minimock.Equal(*mm_want_ptrs.path, mm_got.path) {
-				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter path, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originPath, *mm_want_ptrs.path, mm_got.path, minimock.Diff(*mm_want_ptrs.path, mm_got.path))
+			if mm_want_ptrs.commentIDs != nil && !minimock.Equal(*mm_want_ptrs.commentIDs, mm_got.commentIDs) {
+				mmGetCommentPaths.t.Errorf("CommentQueryImpMock.GetCommentPaths got unexpected parameter commentIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.expectationOrigins.originCommentIDs, *mm_want_ptrs.commentIDs, mm_got.commentIDs, minimock.Diff(*mm_want_ptrs.commentIDs, mm_got.commentIDs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetCommentPaths.t.Errorf("CommentQueryImpMock.GetCommentPaths got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.results
+		mm_results := mmGetCommentPaths.GetCommentPathsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCommentsBranch.t.Fatal("No results are set for the CommentQueryImpMock.GetCommentsBranch")
+			mmGetCommentPaths.t.Fatal("No results are set for the CommentQueryImpMock.GetCommentPaths")
 		}
-		return (*mm_results).cpa1, (*mm_results).err
+		return (*mm_results).m1, (*mm_results).err
 	}
-	if mmGetCommentsBranch.funcGetCommentsBranch != nil {
-		return mmGetCommentsBranch.funcGetCommentsBranch(ctx, postID, path)
+	if mmGetCommentPaths.funcGetCommentPaths != nil {
+		return mmGetCommentPaths.funcGetCommentPaths(ctx, commentIDs)
 	}
-	mmGetCommentsBranch.t.Fatalf("Unexpected call to CommentQueryImpMock.GetCommentsBranch. %v %v %v", ctx, postID, path)
+	mmGetCommentPaths.t.Fatalf("Unexpected call to CommentQueryImpMock.GetCommentPaths. %v %v", ctx, commentIDs)
 	return
 }
 
-// GetCommentsBranchAfterCounter returns a count of finished CommentQueryImpMock.GetCommentsBranch invocations
-func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranchAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCommentsBranch.afterGetCommentsBranchCounter)
+// GetCommentPathsAfterCounter returns a count of finished CommentQueryImpMock.GetCommentPaths invocations
+func (mmGetCommentPaths *CommentQueryImpMock) GetCommentPathsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentPaths.afterGetCommentPathsCounter)
 }
 
-// GetCommentsBranchBeforeCounter returns a count of CommentQueryImpMock.GetCommentsBranch invocations
-func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranchBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCommentsBranch.beforeGetCommentsBranchCounter)
+// GetCommentPathsBeforeCounter returns a count of CommentQueryImpMock.GetCommentPaths invocations
+func (mmGetCommentPaths *CommentQueryImpMock) GetCommentPathsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentPaths.beforeGetCommentPathsCounter)
 }
 
-// Calls returns a list of arguments used in each call to CommentQueryImpMock.GetCommentsBranch.
+// Calls returns a list of arguments used in each call to CommentQueryImpMock.GetCommentPaths.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Calls() []*CommentQueryImpMockGetCommentsBranchParams {
-	mmGetCommentsBranch.mutex.RLock()
+func (mmGetCommentPaths *mCommentQueryImpMockGetCommentPaths) Calls() []*CommentQueryImpMockGetCommentPathsParams {
+	mmGetCommentPaths.mutex.RLock()
 
-	argCopy := make([]*CommentQueryImpMockGetCommentsBranchParams, len(mmGetCommentsBranch.callArgs))
-	copy(argCopy, mmGetCommentsBranch.callArgs)
+	argCopy := make([]*CommentQueryImpMockGetCommentPathsParams, len(mmGetCommentPaths.callArgs))
+	copy(argCopy, mmGetCommentPaths.callArgs)
 
-	mmGetCommentsBranch.mutex.RUnlock()
+	mmGetCommentPaths.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCommentsBranchDone returns true if the count of the GetCommentsBranch invocations corresponds
+// MinimockGetCommentPathsDone returns true if the count of the GetCommentPaths invocations corresponds
 // the number of defined expectations
-func (m *CommentQueryImpMock) MinimockGetCommentsBranchDone() bool {
-	if m.GetCommentsBranchMock.optional {
+func (m *CommentQueryImpMock) MinimockGetCommentPathsDone() bool {
+	if m.GetCommentPathsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCommentsBranchMock.expectations {
+	for _, e := range m.GetCommentPathsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCommentsBranchMock.invocationsDone()
+	return m.GetCommentPathsMock.invocationsDone()
 }
 
-// MinimockGetCommentsBranchInspect logs each unmet expectation
-func (m *CommentQueryImpMock) MinimockGetCommentsBranchInspect() {
-	for _, e := range m.GetCommentsBranchMock.expectations {
+// MinimockGetCommentPathsInspect logs each unmet expectation
+func (m *CommentQueryImpMock) MinimockGetCommentPathsInspect() {
+	for _, e := range m.GetCommentPathsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentPaths at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCommentsBranchCounter := mm_atomic.LoadUint64(&m.afterGetCommentsBranchCounter)
+	afterGetCommentPathsCounter := mm_atomic.LoadUint64(&m.afterGetCommentPathsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCommentsBranchMock.defaultExpectation != nil && afterGetCommentsBranchCounter < 1 {
-		if m.GetCommentsBranchMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s", m.GetCommentsBranchMock.defaultExpectation.returnOrigin)
+	if m.GetCommentPathsMock.defaultExpectation != nil && afterGetCommentPathsCounter < 1 {
+		if m.GetCommentPathsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentPaths at\n%s", m.GetCommentPathsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s with params: %#v", m.GetCommentsBranchMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsBranchMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentPaths at\n%s with params: %#v", m.GetCommentPathsMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentPathsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCommentsBranch != nil && afterGetCommentsBranchCounter < 1 {
-		m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s", m.funcGetCommentsBranchOrigin)
+	if m.funcGetCommentPaths != nil && afterGetCommentPathsCounter < 1 {
+		m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentPaths at\n%s", m.funcGetCommentPathsOrigin)
 	}
 
-	if !m.GetCommentsBranchMock.invocationsDone() && afterGetCommentsBranchCounter > 0 {
-		m.t.Errorf("Expected %d calls to CommentQueryImpMock.GetCommentsBranch at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCommentsBranchMock.expectedInvocations), m.GetCommentsBranchMock.expectedInvocationsOrigin, afterGetCommentsBranchCounter)
+	if !m.GetCommentPathsMock.invocationsDone() && afterGetCommentPathsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentQueryImpMock.GetCommentPaths at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentPathsMock.expectedInvocations), m.GetCommentPathsMock.expectedInvocationsOrigin, afterGetCommentPathsCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *CommentQueryImpMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetCommentPathInspect()
+type mCommentQueryImpMockGetCommentVotes struct {
+	optional           bool
+	mock               *CommentQueryImpMock
+	defaultExpectation *CommentQueryImpMockGetCommentVotesExpectation
+	expectations       []*CommentQueryImpMockGetCommentVotesExpectation
 
-			m.MinimockGetCommentsBranchInspect()
+	callArgs []*CommentQueryImpMockGetCommentVotesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentQueryImpMockGetCommentVotesExpectation specifies expectation struct of the CommentQueryImp.GetCommentVotes
+type CommentQueryImpMockGetCommentVotesExpectation struct {
+	mock               *CommentQueryImpMock
+	params             *CommentQueryImpMockGetCommentVotesParams
+	paramPtrs          *CommentQueryImpMockGetCommentVotesParamPtrs
+	expectationOrigins CommentQueryImpMockGetCommentVotesExpectationOrigins
+	results            *CommentQueryImpMockGetCommentVotesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentQueryImpMockGetCommentVotesParams contains parameters of the CommentQueryImp.GetCommentVotes
+type CommentQueryImpMockGetCommentVotesParams struct {
+	ctx        context.Context
+	userID     uuid.UUID
+	commentIDs []int64
+}
+
+// CommentQueryImpMockGetCommentVotesParamPtrs contains pointers to parameters of the CommentQueryImp.GetCommentVotes
+type CommentQueryImpMockGetCommentVotesParamPtrs struct {
+	ctx        *context.Context
+	userID     *uuid.UUID
+	commentIDs *[]int64
+}
+
+// CommentQueryImpMockGetCommentVotesResults contains results of the CommentQueryImp.GetCommentVotes
+type CommentQueryImpMockGetCommentVotesResults struct {
+	m1  map[int64]model.VoteValue
+	err error
+}
+
+// CommentQueryImpMockGetCommentVotesOrigins contains origins of expectations of the CommentQueryImp.GetCommentVotes
+type CommentQueryImpMockGetCommentVotesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originCommentIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Optional() *mCommentQueryImpMockGetCommentVotes {
+	mmGetCommentVotes.optional = true
+	return mmGetCommentVotes
+}
+
+// Expect sets up expected params for CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Expect(ctx context.Context, userID uuid.UUID, commentIDs []int64) *mCommentQueryImpMockGetCommentVotes {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	if mmGetCommentVotes.defaultExpectation == nil {
+		mmGetCommentVotes.defaultExpectation = &CommentQueryImpMockGetCommentVotesExpectation{}
+	}
+
+	if mmGetCommentVotes.defaultExpectation.paramPtrs != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentVotes.defaultExpectation.params = &CommentQueryImpMockGetCommentVotesParams{ctx, userID, commentIDs}
+	mmGetCommentVotes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentVotes.expectations {
+		if minimock.Equal(e.params, mmGetCommentVotes.defaultExpectation.params) {
+			mmGetCommentVotes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentVotes.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentVotes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) ExpectCtxParam1(ctx context.Context) *mCommentQueryImpMockGetCommentVotes {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	if mmGetCommentVotes.defaultExpectation == nil {
+		mmGetCommentVotes.defaultExpectation = &CommentQueryImpMockGetCommentVotesExpectation{}
+	}
+
+	if mmGetCommentVotes.defaultExpectation.params != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Expect")
+	}
+
+	if mmGetCommentVotes.defaultExpectation.paramPtrs == nil {
+		mmGetCommentVotes.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentVotesParamPtrs{}
+	}
+	mmGetCommentVotes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentVotes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentVotes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) ExpectUserIDParam2(userID uuid.UUID) *mCommentQueryImpMockGetCommentVotes {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	if mmGetCommentVotes.defaultExpectation == nil {
+		mmGetCommentVotes.defaultExpectation = &CommentQueryImpMockGetCommentVotesExpectation{}
+	}
+
+	if mmGetCommentVotes.defaultExpectation.params != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Expect")
+	}
+
+	if mmGetCommentVotes.defaultExpectation.paramPtrs == nil {
+		mmGetCommentVotes.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentVotesParamPtrs{}
+	}
+	mmGetCommentVotes.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCommentVotes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCommentVotes
+}
+
+// ExpectCommentIDsParam3 sets up expected param commentIDs for CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) ExpectCommentIDsParam3(commentIDs []int64) *mCommentQueryImpMockGetCommentVotes {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	if mmGetCommentVotes.defaultExpectation == nil {
+		mmGetCommentVotes.defaultExpectation = &CommentQueryImpMockGetCommentVotesExpectation{}
+	}
+
+	if mmGetCommentVotes.defaultExpectation.params != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Expect")
+	}
+
+	if mmGetCommentVotes.defaultExpectation.paramPtrs == nil {
+		mmGetCommentVotes.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentVotesParamPtrs{}
+	}
+	mmGetCommentVotes.defaultExpectation.paramPtrs.commentIDs = &commentIDs
+	mmGetCommentVotes.defaultExpectation.expectationOrigins.originCommentIDs = minimock.CallerInfo(1)
+
+	return mmGetCommentVotes
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Inspect(f func(ctx context.Context, userID uuid.UUID, commentIDs []int64)) *mCommentQueryImpMockGetCommentVotes {
+	if mmGetCommentVotes.mock.inspectFuncGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("Inspect function is already set for CommentQueryImpMock.GetCommentVotes")
+	}
+
+	mmGetCommentVotes.mock.inspectFuncGetCommentVotes = f
+
+	return mmGetCommentVotes
+}
+
+// Return sets up results that will be returned by CommentQueryImp.GetCommentVotes
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Return(m1 map[int64]model.VoteValue, err error) *CommentQueryImpMock {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	if mmGetCommentVotes.defaultExpectation == nil {
+		mmGetCommentVotes.defaultExpectation = &CommentQueryImpMockGetCommentVotesExpectation{mock: mmGetCommentVotes.mock}
+	}
+	mmGetCommentVotes.defaultExpectation.results = &CommentQueryImpMockGetCommentVotesResults{m1, err}
+	mmGetCommentVotes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentVotes.mock
+}
+
+// Set uses given function f to mock the CommentQueryImp.GetCommentVotes method
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Set(f func(ctx context.Context, userID uuid.UUID, commentIDs []int64) (m1 map[int64]model.VoteValue, err error)) *CommentQueryImpMock {
+	if mmGetCommentVotes.defaultExpectation != nil {
+		mmGetCommentVotes.mock.t.Fatalf("Default expectation is already set for the CommentQueryImp.GetCommentVotes method")
+	}
+
+	if len(mmGetCommentVotes.expectations) > 0 {
+		mmGetCommentVotes.mock.t.Fatalf("Some expectations are already set for the CommentQueryImp.GetCommentVotes method")
+	}
+
+	mmGetCommentVotes.mock.funcGetCommentVotes = f
+	mmGetCommentVotes.mock.funcGetCommentVotesOrigin = minimock.CallerInfo(1)
+	return mmGetCommentVotes.mock
+}
+
+// When sets expectation for the CommentQueryImp.GetCommentVotes which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) When(ctx context.Context, userID uuid.UUID, commentIDs []int64) *CommentQueryImpMockGetCommentVotesExpectation {
+	if mmGetCommentVotes.mock.funcGetCommentVotes != nil {
+		mmGetCommentVotes.mock.t.Fatalf("CommentQueryImpMock.GetCommentVotes mock is already set by Set")
+	}
+
+	expectation := &CommentQueryImpMockGetCommentVotesExpectation{
+		mock:               mmGetCommentVotes.mock,
+		params:             &CommentQueryImpMockGetCommentVotesParams{ctx, userID, commentIDs},
+		expectationOrigins: CommentQueryImpMockGetCommentVotesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentVotes.expectations = append(mmGetCommentVotes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentQueryImp.GetCommentVotes return parameters for the expectation previously defined by the When method
+func (e *CommentQueryImpMockGetCommentVotesExpectation) Then(m1 map[int64]model.VoteValue, err error) *CommentQueryImpMock {
+	e.results = &CommentQueryImpMockGetCommentVotesResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentQueryImp.GetCommentVotes should be invoked
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Times(n uint64) *mCommentQueryImpMockGetCommentVotes {
+	if n == 0 {
+		mmGetCommentVotes.mock.t.Fatalf("Times of CommentQueryImpMock.GetCommentVotes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentVotes.expectedInvocations, n)
+	mmGetCommentVotes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentVotes
+}
+
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) invocationsDone() bool {
+	if len(mmGetCommentVotes.expectations) == 0 && mmGetCommentVotes.defaultExpectation == nil && mmGetCommentVotes.mock.funcGetCommentVotes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentVotes.mock.afterGetCommentVotesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentVotes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentVotes implements CommentQueryImp
+func (mmGetCommentVotes *CommentQueryImpMock) GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (m1 map[int64]model.VoteValue, err error) {
+	mm_atomic.AddUint64(&mmGetCommentVotes.beforeGetCommentVotesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentVotes.afterGetCommentVotesCounter, 1)
+
+	mmGetCommentVotes.t.Helper()
+
+	if mmGetCommentVotes.inspectFuncGetCommentVotes != nil {
+		mmGetCommentVotes.inspectFuncGetCommentVotes(ctx, userID, commentIDs)
+	}
+
+	mm_params := CommentQueryImpMockGetCommentVotesParams{ctx, userID, commentIDs}
+
+	// Record call args
+	mmGetCommentVotes.GetCommentVotesMock.mutex.Lock()
+	mmGetCommentVotes.GetCommentVotesMock.callArgs = append(mmGetCommentVotes.GetCommentVotesMock.callArgs, &mm_params)
+	mmGetCommentVotes.GetCommentVotesMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentVotes.GetCommentVotesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetCommentVotes.GetCommentVotesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentQueryImpMockGetCommentVotesParams{ctx, userID, commentIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentVotes.t.Errorf("CommentQueryImpMock.GetCommentVotes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCommentVotes.t.Errorf("CommentQueryImpMock.GetCommentVotes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.commentIDs != nil && !minimock.Equal(*mm_want_ptrs.commentIDs, mm_got.commentIDs) {
+				mmGetCommentVotes.t.Errorf("CommentQueryImpMock.GetCommentVotes got unexpected parameter commentIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.expectationOrigins.originCommentIDs, *mm_want_ptrs.commentIDs, mm_got.commentIDs, minimock.Diff(*mm_want_ptrs.commentIDs, mm_got.commentIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentVotes.t.Errorf("CommentQueryImpMock.GetCommentVotes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentVotes.GetCommentVotesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentVotes.t.Fatal("No results are set for the CommentQueryImpMock.GetCommentVotes")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetCommentVotes.funcGetCommentVotes != nil {
+		return mmGetCommentVotes.funcGetCommentVotes(ctx, userID, commentIDs)
+	}
+	mmGetCommentVotes.t.Fatalf("Unexpected call to CommentQueryImpMock.GetCommentVotes. %v %v %v", ctx, userID, commentIDs)
+	return
+}
+
+// GetCommentVotesAfterCounter returns a count of finished CommentQueryImpMock.GetCommentVotes invocations
+func (mmGetCommentVotes *CommentQueryImpMock) GetCommentVotesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentVotes.afterGetCommentVotesCounter)
+}
+
+// GetCommentVotesBeforeCounter returns a count of CommentQueryImpMock.GetCommentVotes invocations
+func (mmGetCommentVotes *CommentQueryImpMock) GetCommentVotesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentVotes.beforeGetCommentVotesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentQueryImpMock.GetCommentVotes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentVotes *mCommentQueryImpMockGetCommentVotes) Calls() []*CommentQueryImpMockGetCommentVotesParams {
+	mmGetCommentVotes.mutex.RLock()
+
+	argCopy := make([]*CommentQueryImpMockGetCommentVotesParams, len(mmGetCommentVotes.callArgs))
+	copy(argCopy, mmGetCommentVotes.callArgs)
+
+	mmGetCommentVotes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentVotesDone returns true if the count of the GetCommentVotes invocations corresponds
+// the number of defined expectations
+func (m *CommentQueryImpMock) MinimockGetCommentVotesDone() bool {
+	if m.GetCommentVotesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentVotesMock.invocationsDone()
+}
+
+// MinimockGetCommentVotesInspect logs each unmet expectation
+func (m *CommentQueryImpMock) MinimockGetCommentVotesInspect() {
+	for _, e := range m.GetCommentVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentVotes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentVotesCounter := mm_atomic.LoadUint64(&m.afterGetCommentVotesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentVotesMock.defaultExpectation != nil && afterGetCommentVotesCounter < 1 {
+		if m.GetCommentVotesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentVotes at\n%s", m.GetCommentVotesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentVotes at\n%s with params: %#v", m.GetCommentVotesMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentVotesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentVotes != nil && afterGetCommentVotesCounter < 1 {
+		m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentVotes at\n%s", m.funcGetCommentVotesOrigin)
+	}
+
+	if !m.GetCommentVotesMock.invocationsDone() && afterGetCommentVotesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentQueryImpMock.GetCommentVotes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentVotesMock.expectedInvocations), m.GetCommentVotesMock.expectedInvocationsOrigin, afterGetCommentVotesCounter)
+	}
+}
+
+type mCommentQueryImpMockGetCommentsBranch struct {
+	optional           bool
+	mock               *CommentQueryImpMock
+	defaultExpectation *CommentQueryImpMockGetCommentsBranchExpectation
+	expectations       []*CommentQueryImpMockGetCommentsBranchExpectation
+
+	callArgs []*CommentQueryImpMockGetCommentsBranchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentQueryImpMockGetCommentsBranchExpectation specifies expectation struct of the CommentQueryImp.GetCommentsBranch
+type CommentQueryImpMockGetCommentsBranchExpectation struct {
+	mock               *CommentQueryImpMock
+	params             *CommentQueryImpMockGetCommentsBranchParams
+	paramPtrs          *CommentQueryImpMockGetCommentsBranchParamPtrs
+	expectationOrigins CommentQueryImpMockGetCommentsBranchExpectationOrigins
+	results            *CommentQueryImpMockGetCommentsBranchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentQueryImpMockGetCommentsBranchParams contains parameters of the CommentQueryImp.GetCommentsBranch
+type CommentQueryImpMockGetCommentsBranchParams struct {
+	ctx    context.Context
+	postID int64
+	path   string
+}
+
+// CommentQueryImpMockGetCommentsBranchParamPtrs contains pointers to parameters of the CommentQueryImp.GetCommentsBranch
+type CommentQueryImpMockGetCommentsBranchParamPtrs struct {
+	ctx    *context.Context
+	postID *int64
+	path   *string
+}
+
+// CommentQueryImpMockGetCommentsBranchResults contains results of the CommentQueryImp.GetCommentsBranch
+type CommentQueryImpMockGetCommentsBranchResults struct {
+	cpa1 []*model.Comment
+	err  error
+}
+
+// CommentQueryImpMockGetCommentsBranchOrigins contains origins of expectations of the CommentQueryImp.GetCommentsBranch
+type CommentQueryImpMockGetCommentsBranchExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPostID string
+	originPath   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Optional() *mCommentQueryImpMockGetCommentsBranch {
+	mmGetCommentsBranch.optional = true
+	return mmGetCommentsBranch
+}
+
+// Expect sets up expected params for CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Expect(ctx context.Context, postID int64, path string) *mCommentQueryImpMockGetCommentsBranch {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation == nil {
+		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.paramPtrs != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentsBranch.defaultExpectation.params = &CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
+	mmGetCommentsBranch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentsBranch.expectations {
+		if minimock.Equal(e.params, mmGetCommentsBranch.defaultExpectation.params) {
+			mmGetCommentsBranch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsBranch.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentsBranch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectCtxParam1(ctx context.Context) *mCommentQueryImpMockGetCommentsBranch {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation == nil {
+		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.params != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
+	}
+	mmGetCommentsBranch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentsBranch
+}
+
+// ExpectPostIDParam2 sets up expected param postID for CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectPostIDParam2(postID int64) *mCommentQueryImpMockGetCommentsBranch {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation == nil {
+		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.params != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
+	}
+	mmGetCommentsBranch.defaultExpectation.paramPtrs.postID = &postID
+	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmGetCommentsBranch
+}
+
+// ExpectPathParam3 sets up expected param path for CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) ExpectPathParam3(path string) *mCommentQueryImpMockGetCommentsBranch {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation == nil {
+		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{}
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.params != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Expect")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsBranch.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchParamPtrs{}
+	}
+	mmGetCommentsBranch.defaultExpectation.paramPtrs.path = &path
+	mmGetCommentsBranch.defaultExpectation.expectationOrigins.originPath = minimock.CallerInfo(1)
+
+	return mmGetCommentsBranch
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Inspect(f func(ctx context.Context, postID int64, path string)) *mCommentQueryImpMockGetCommentsBranch {
+	if mmGetCommentsBranch.mock.inspectFuncGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("Inspect function is already set for CommentQueryImpMock.GetCommentsBranch")
+	}
+
+	mmGetCommentsBranch.mock.inspectFuncGetCommentsBranch = f
+
+	return mmGetCommentsBranch
+}
+
+// Return sets up results that will be returned by CommentQueryImp.GetCommentsBranch
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Return(cpa1 []*model.Comment, err error) *CommentQueryImpMock {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	if mmGetCommentsBranch.defaultExpectation == nil {
+		mmGetCommentsBranch.defaultExpectation = &CommentQueryImpMockGetCommentsBranchExpectation{mock: mmGetCommentsBranch.mock}
+	}
+	mmGetCommentsBranch.defaultExpectation.results = &CommentQueryImpMockGetCommentsBranchResults{cpa1, err}
+	mmGetCommentsBranch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranch.mock
+}
+
+// Set uses given function f to mock the CommentQueryImp.GetCommentsBranch method
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Set(f func(ctx context.Context, postID int64, path string) (cpa1 []*model.Comment, err error)) *CommentQueryImpMock {
+	if mmGetCommentsBranch.defaultExpectation != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("Default expectation is already set for the CommentQueryImp.GetCommentsBranch method")
+	}
+
+	if len(mmGetCommentsBranch.expectations) > 0 {
+		mmGetCommentsBranch.mock.t.Fatalf("Some expectations are already set for the CommentQueryImp.GetCommentsBranch method")
+	}
+
+	mmGetCommentsBranch.mock.funcGetCommentsBranch = f
+	mmGetCommentsBranch.mock.funcGetCommentsBranchOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranch.mock
+}
+
+// When sets expectation for the CommentQueryImp.GetCommentsBranch which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) When(ctx context.Context, postID int64, path string) *CommentQueryImpMockGetCommentsBranchExpectation {
+	if mmGetCommentsBranch.mock.funcGetCommentsBranch != nil {
+		mmGetCommentsBranch.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranch mock is already set by Set")
+	}
+
+	expectation := &CommentQueryImpMockGetCommentsBranchExpectation{
+		mock:               mmGetCommentsBranch.mock,
+		params:             &CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path},
+		expectationOrigins: CommentQueryImpMockGetCommentsBranchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentsBranch.expectations = append(mmGetCommentsBranch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentQueryImp.GetCommentsBranch return parameters for the expectation previously defined by the When method
+func (e *CommentQueryImpMockGetCommentsBranchExpectation) Then(cpa1 []*model.Comment, err error) *CommentQueryImpMock {
+	e.results = &CommentQueryImpMockGetCommentsBranchResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentQueryImp.GetCommentsBranch should be invoked
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Times(n uint64) *mCommentQueryImpMockGetCommentsBranch {
+	if n == 0 {
+		mmGetCommentsBranch.mock.t.Fatalf("Times of CommentQueryImpMock.GetCommentsBranch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentsBranch.expectedInvocations, n)
+	mmGetCommentsBranch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranch
+}
+
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) invocationsDone() bool {
+	if len(mmGetCommentsBranch.expectations) == 0 && mmGetCommentsBranch.defaultExpectation == nil && mmGetCommentsBranch.mock.funcGetCommentsBranch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranch.mock.afterGetCommentsBranchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentsBranch implements CommentQueryImp
+func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranch(ctx context.Context, postID int64, path string) (cpa1 []*model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetCommentsBranch.beforeGetCommentsBranchCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentsBranch.afterGetCommentsBranchCounter, 1)
+
+	mmGetCommentsBranch.t.Helper()
+
+	if mmGetCommentsBranch.inspectFuncGetCommentsBranch != nil {
+		mmGetCommentsBranch.inspectFuncGetCommentsBranch(ctx, postID, path)
+	}
+
+	mm_params := CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
+
+	// Record call args
+	mmGetCommentsBranch.GetCommentsBranchMock.mutex.Lock()
+	mmGetCommentsBranch.GetCommentsBranchMock.callArgs = append(mmGetCommentsBranch.GetCommentsBranchMock.callArgs, &mm_params)
+	mmGetCommentsBranch.GetCommentsBranchMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentsBranch.GetCommentsBranchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentQueryImpMockGetCommentsBranchParams{ctx, postID, path}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.path != nil && !minimock.Equal(*mm_want_ptrs.path, mm_got.path) {
+				mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameter path, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.originPath, *mm_want_ptrs.path, mm_got.path, minimock.Diff(*mm_want_ptrs.path, mm_got.path))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentsBranch.t.Errorf("CommentQueryImpMock.GetCommentsBranch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentsBranch.GetCommentsBranchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentsBranch.t.Fatal("No results are set for the CommentQueryImpMock.GetCommentsBranch")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCommentsBranch.funcGetCommentsBranch != nil {
+		return mmGetCommentsBranch.funcGetCommentsBranch(ctx, postID, path)
+	}
+	mmGetCommentsBranch.t.Fatalf("Unexpected call to CommentQueryImpMock.GetCommentsBranch. %v %v %v", ctx, postID, path)
+	return
+}
+
+// GetCommentsBranchAfterCounter returns a count of finished CommentQueryImpMock.GetCommentsBranch invocations
+func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsBranch.afterGetCommentsBranchCounter)
+}
+
+// GetCommentsBranchBeforeCounter returns a count of CommentQueryImpMock.GetCommentsBranch invocations
+func (mmGetCommentsBranch *CommentQueryImpMock) GetCommentsBranchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsBranch.beforeGetCommentsBranchCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentQueryImpMock.GetCommentsBranch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentsBranch *mCommentQueryImpMockGetCommentsBranch) Calls() []*CommentQueryImpMockGetCommentsBranchParams {
+	mmGetCommentsBranch.mutex.RLock()
+
+	argCopy := make([]*CommentQueryImpMockGetCommentsBranchParams, len(mmGetCommentsBranch.callArgs))
+	copy(argCopy, mmGetCommentsBranch.callArgs)
+
+	mmGetCommentsBranch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentsBranchDone returns true if the count of the GetCommentsBranch invocations corresponds
+// the number of defined expectations
+func (m *CommentQueryImpMock) MinimockGetCommentsBranchDone() bool {
+	if m.GetCommentsBranchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentsBranchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentsBranchMock.invocationsDone()
+}
+
+// MinimockGetCommentsBranchInspect logs each unmet expectation
+func (m *CommentQueryImpMock) MinimockGetCommentsBranchInspect() {
+	for _, e := range m.GetCommentsBranchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentsBranchCounter := mm_atomic.LoadUint64(&m.afterGetCommentsBranchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentsBranchMock.defaultExpectation != nil && afterGetCommentsBranchCounter < 1 {
+		if m.GetCommentsBranchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s", m.GetCommentsBranchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s with params: %#v", m.GetCommentsBranchMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsBranchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentsBranch != nil && afterGetCommentsBranchCounter < 1 {
+		m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranch at\n%s", m.funcGetCommentsBranchOrigin)
+	}
+
+	if !m.GetCommentsBranchMock.invocationsDone() && afterGetCommentsBranchCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentQueryImpMock.GetCommentsBranch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentsBranchMock.expectedInvocations), m.GetCommentsBranchMock.expectedInvocationsOrigin, afterGetCommentsBranchCounter)
+	}
+}
+
+type mCommentQueryImpMockGetCommentsBranches struct {
+	optional           bool
+	mock               *CommentQueryImpMock
+	defaultExpectation *CommentQueryImpMockGetCommentsBranchesExpectation
+	expectations       []*CommentQueryImpMockGetCommentsBranchesExpectation
+
+	callArgs []*CommentQueryImpMockGetCommentsBranchesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CommentQueryImpMockGetCommentsBranchesExpectation specifies expectation struct of the CommentQueryImp.GetCommentsBranches
+type CommentQueryImpMockGetCommentsBranchesExpectation struct {
+	mock               *CommentQueryImpMock
+	params             *CommentQueryImpMockGetCommentsBranchesParams
+	paramPtrs          *CommentQueryImpMockGetCommentsBranchesParamPtrs
+	expectationOrigins CommentQueryImpMockGetCommentsBranchesExpectationOrigins
+	results            *CommentQueryImpMockGetCommentsBranchesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CommentQueryImpMockGetCommentsBranchesParams contains parameters of the CommentQueryImp.GetCommentsBranches
+type CommentQueryImpMockGetCommentsBranchesParams struct {
+	ctx  context.Context
+	keys []model.BranchKey
+}
+
+// CommentQueryImpMockGetCommentsBranchesParamPtrs contains pointers to parameters of the CommentQueryImp.GetCommentsBranches
+type CommentQueryImpMockGetCommentsBranchesParamPtrs struct {
+	ctx  *context.Context
+	keys *[]model.BranchKey
+}
+
+// CommentQueryImpMockGetCommentsBranchesResults contains results of the CommentQueryImp.GetCommentsBranches
+type CommentQueryImpMockGetCommentsBranchesResults struct {
+	m1  map[model.BranchKey][]*model.Comment
+	err error
+}
+
+// CommentQueryImpMockGetCommentsBranchesOrigins contains origins of expectations of the CommentQueryImp.GetCommentsBranches
+type CommentQueryImpMockGetCommentsBranchesExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKeys string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Optional() *mCommentQueryImpMockGetCommentsBranches {
+	mmGetCommentsBranches.optional = true
+	return mmGetCommentsBranches
+}
+
+// Expect sets up expected params for CommentQueryImp.GetCommentsBranches
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Expect(ctx context.Context, keys []model.BranchKey) *mCommentQueryImpMockGetCommentsBranches {
+	if mmGetCommentsBranches.mock.funcGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Set")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation == nil {
+		mmGetCommentsBranches.defaultExpectation = &CommentQueryImpMockGetCommentsBranchesExpectation{}
+	}
+
+	if mmGetCommentsBranches.defaultExpectation.paramPtrs != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentsBranches.defaultExpectation.params = &CommentQueryImpMockGetCommentsBranchesParams{ctx, keys}
+	mmGetCommentsBranches.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentsBranches.expectations {
+		if minimock.Equal(e.params, mmGetCommentsBranches.defaultExpectation.params) {
+			mmGetCommentsBranches.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsBranches.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentsBranches
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommentQueryImp.GetCommentsBranches
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) ExpectCtxParam1(ctx context.Context) *mCommentQueryImpMockGetCommentsBranches {
+	if mmGetCommentsBranches.mock.funcGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Set")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation == nil {
+		mmGetCommentsBranches.defaultExpectation = &CommentQueryImpMockGetCommentsBranchesExpectation{}
+	}
+
+	if mmGetCommentsBranches.defaultExpectation.params != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Expect")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsBranches.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchesParamPtrs{}
+	}
+	mmGetCommentsBranches.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentsBranches.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentsBranches
+}
+
+// ExpectKeysParam2 sets up expected param keys for CommentQueryImp.GetCommentsBranches
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) ExpectKeysParam2(keys []model.BranchKey) *mCommentQueryImpMockGetCommentsBranches {
+	if mmGetCommentsBranches.mock.funcGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Set")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation == nil {
+		mmGetCommentsBranches.defaultExpectation = &CommentQueryImpMockGetCommentsBranchesExpectation{}
+	}
+
+	if mmGetCommentsBranches.defaultExpectation.params != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Expect")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsBranches.defaultExpectation.paramPtrs = &CommentQueryImpMockGetCommentsBranchesParamPtrs{}
+	}
+	mmGetCommentsBranches.defaultExpectation.paramPtrs.keys = &keys
+	mmGetCommentsBranches.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmGetCommentsBranches
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommentQueryImp.GetCommentsBranches
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Inspect(f func(ctx context.Context, keys []model.BranchKey)) *mCommentQueryImpMockGetCommentsBranches {
+	if mmGetCommentsBranches.mock.inspectFuncGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("Inspect function is already set for CommentQueryImpMock.GetCommentsBranches")
+	}
+
+	mmGetCommentsBranches.mock.inspectFuncGetCommentsBranches = f
+
+	return mmGetCommentsBranches
+}
+
+// Return sets up results that will be returned by CommentQueryImp.GetCommentsBranches
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Return(m1 map[model.BranchKey][]*model.Comment, err error) *CommentQueryImpMock {
+	if mmGetCommentsBranches.mock.funcGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Set")
+	}
+
+	if mmGetCommentsBranches.defaultExpectation == nil {
+		mmGetCommentsBranches.defaultExpectation = &CommentQueryImpMockGetCommentsBranchesExpectation{mock: mmGetCommentsBranches.mock}
+	}
+	mmGetCommentsBranches.defaultExpectation.results = &CommentQueryImpMockGetCommentsBranchesResults{m1, err}
+	mmGetCommentsBranches.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranches.mock
+}
+
+// Set uses given function f to mock the CommentQueryImp.GetCommentsBranches method
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Set(f func(ctx context.Context, keys []model.BranchKey) (m1 map[model.BranchKey][]*model.Comment, err error)) *CommentQueryImpMock {
+	if mmGetCommentsBranches.defaultExpectation != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("Default expectation is already set for the CommentQueryImp.GetCommentsBranches method")
+	}
+
+	if len(mmGetCommentsBranches.expectations) > 0 {
+		mmGetCommentsBranches.mock.t.Fatalf("Some expectations are already set for the CommentQueryImp.GetCommentsBranches method")
+	}
+
+	mmGetCommentsBranches.mock.funcGetCommentsBranches = f
+	mmGetCommentsBranches.mock.funcGetCommentsBranchesOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranches.mock
+}
+
+// When sets expectation for the CommentQueryImp.GetCommentsBranches which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) When(ctx context.Context, keys []model.BranchKey) *CommentQueryImpMockGetCommentsBranchesExpectation {
+	if mmGetCommentsBranches.mock.funcGetCommentsBranches != nil {
+		mmGetCommentsBranches.mock.t.Fatalf("CommentQueryImpMock.GetCommentsBranches mock is already set by Set")
+	}
+
+	expectation := &CommentQueryImpMockGetCommentsBranchesExpectation{
+		mock:               mmGetCommentsBranches.mock,
+		params:             &CommentQueryImpMockGetCommentsBranchesParams{ctx, keys},
+		expectationOrigins: CommentQueryImpMockGetCommentsBranchesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentsBranches.expectations = append(mmGetCommentsBranches.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommentQueryImp.GetCommentsBranches return parameters for the expectation previously defined by the When method
+func (e *CommentQueryImpMockGetCommentsBranchesExpectation) Then(m1 map[model.BranchKey][]*model.Comment, err error) *CommentQueryImpMock {
+	e.results = &CommentQueryImpMockGetCommentsBranchesResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times CommentQueryImp.GetCommentsBranches should be invoked
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Times(n uint64) *mCommentQueryImpMockGetCommentsBranches {
+	if n == 0 {
+		mmGetCommentsBranches.mock.t.Fatalf("Times of CommentQueryImpMock.GetCommentsBranches mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentsBranches.expectedInvocations, n)
+	mmGetCommentsBranches.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsBranches
+}
+
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) invocationsDone() bool {
+	if len(mmGetCommentsBranches.expectations) == 0 && mmGetCommentsBranches.defaultExpectation == nil && mmGetCommentsBranches.mock.funcGetCommentsBranches == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranches.mock.afterGetCommentsBranchesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsBranches.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentsBranches implements CommentQueryImp
+func (mmGetCommentsBranches *CommentQueryImpMock) GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (m1 map[model.BranchKey][]*model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetCommentsBranches.beforeGetCommentsBranchesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentsBranches.afterGetCommentsBranchesCounter, 1)
+
+	mmGetCommentsBranches.t.Helper()
+
+	if mmGetCommentsBranches.inspectFuncGetCommentsBranches != nil {
+		mmGetCommentsBranches.inspectFuncGetCommentsBranches(ctx, keys)
+	}
+
+	mm_params := CommentQueryImpMockGetCommentsBranchesParams{ctx, keys}
+
+	// Record call args
+	mmGetCommentsBranches.GetCommentsBranchesMock.mutex.Lock()
+	mmGetCommentsBranches.GetCommentsBranchesMock.callArgs = append(mmGetCommentsBranches.GetCommentsBranchesMock.callArgs, &mm_params)
+	mmGetCommentsBranches.GetCommentsBranchesMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentsBranches.GetCommentsBranchesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.paramPtrs
+
+		mm_got := CommentQueryImpMockGetCommentsBranchesParams{ctx, keys}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentsBranches.t.Errorf("CommentQueryImpMock.GetCommentsBranches got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmGetCommentsBranches.t.Errorf("CommentQueryImpMock.GetCommentsBranches got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentsBranches.t.Errorf("CommentQueryImpMock.GetCommentsBranches got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentsBranches.GetCommentsBranchesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentsBranches.t.Fatal("No results are set for the CommentQueryImpMock.GetCommentsBranches")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetCommentsBranches.funcGetCommentsBranches != nil {
+		return mmGetCommentsBranches.funcGetCommentsBranches(ctx, keys)
+	}
+	mmGetCommentsBranches.t.Fatalf("Unexpected call to CommentQueryImpMock.GetCommentsBranches. %v %v", ctx, keys)
+	return
+}
+
+// GetCommentsBranchesAfterCounter returns a count of finished CommentQueryImpMock.GetCommentsBranches invocations
+func (mmGetCommentsBranches *CommentQueryImpMock) GetCommentsBranchesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsBranches.afterGetCommentsBranchesCounter)
+}
+
+// GetCommentsBranchesBeforeCounter returns a count of CommentQueryImpMock.GetCommentsBranches invocations
+func (mmGetCommentsBranches *CommentQueryImpMock) GetCommentsBranchesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsBranches.beforeGetCommentsBranchesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommentQueryImpMock.GetCommentsBranches.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentsBranches *mCommentQueryImpMockGetCommentsBranches) Calls() []*CommentQueryImpMockGetCommentsBranchesParams {
+	mmGetCommentsBranches.mutex.RLock()
+
+	argCopy := make([]*CommentQueryImpMockGetCommentsBranchesParams, len(mmGetCommentsBranches.callArgs))
+	copy(argCopy, mmGetCommentsBranches.callArgs)
+
+	mmGetCommentsBranches.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentsBranchesDone returns true if the count of the GetCommentsBranches invocations corresponds
+// the number of defined expectations
+func (m *CommentQueryImpMock) MinimockGetCommentsBranchesDone() bool {
+	if m.GetCommentsBranchesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentsBranchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentsBranchesMock.invocationsDone()
+}
+
+// MinimockGetCommentsBranchesInspect logs each unmet expectation
+func (m *CommentQueryImpMock) MinimockGetCommentsBranchesInspect() {
+	for _, e := range m.GetCommentsBranchesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranches at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentsBranchesCounter := mm_atomic.LoadUint64(&m.afterGetCommentsBranchesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentsBranchesMock.defaultExpectation != nil && afterGetCommentsBranchesCounter < 1 {
+		if m.GetCommentsBranchesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranches at\n%s", m.GetCommentsBranchesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranches at\n%s with params: %#v", m.GetCommentsBranchesMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsBranchesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentsBranches != nil && afterGetCommentsBranchesCounter < 1 {
+		m.t.Errorf("Expected call to CommentQueryImpMock.GetCommentsBranches at\n%s", m.funcGetCommentsBranchesOrigin)
+	}
+
+	if !m.GetCommentsBranchesMock.invocationsDone() && afterGetCommentsBranchesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommentQueryImpMock.GetCommentsBranches at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentsBranchesMock.expectedInvocations), m.GetCommentsBranchesMock.expectedInvocationsOrigin, afterGetCommentsBranchesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CommentQueryImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetCommentPathInspect()
+
+			m.MinimockGetCommentPathsInspect()
+
+			m.MinimockGetCommentVotesInspect()
+
+			m.MinimockGetCommentsBranchInspect()
+
+			m.MinimockGetCommentsBranchesInspect()
 		}
 	})
 }
@@ -801,5 +1898,8 @@ func (m *CommentQueryImpMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockGetCommentPathDone() &&
-		m.MinimockGetCommentsBranchDone()
+		m.MinimockGetCommentPathsDone() &&
+		m.MinimockGetCommentVotesDone() &&
+		m.MinimockGetCommentsBranchDone() &&
+		m.MinimockGetCommentsBranchesDone()
 }
@@ -3,6 +3,7 @@ package commentquery
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
@@ -10,4 +11,7 @@ import (
 type CommentQueryImp interface {
 	GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error)
 	GetCommentPath(ctx context.Context, parentID int64) (string, error)
+	GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error)
+	GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error)
+	GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error)
 }
@@ -0,0 +1,110 @@
+package commentquery
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/dataloader"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+)
+
+const (
+	loaderWait     = time.Millisecond
+	loaderMaxBatch = 100
+)
+
+type loadersKey struct{}
+
+type loaders struct {
+	paths    *dataloader.Loader[int64, string]
+	branches *dataloader.Loader[model.BranchKey, []*model.Comment]
+	votes    *dataloader.Loader[int64, model.VoteValue]
+}
+
+// WithLoaders returns the context with the loaders batching the comment
+// lookups of one request.
+func (h *CommentQuery) WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		paths:    dataloader.New(h.loadPaths, loaderWait, loaderMaxBatch),
+		branches: dataloader.New(h.loadBranches, loaderWait, loaderMaxBatch),
+		votes:    dataloader.New(h.loadVotes, loaderWait, loaderMaxBatch),
+	})
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
+
+func (h *CommentQuery) loadPaths(ctx context.Context, commentIDs []int64) ([]string, []error) {
+	paths, err := h.commentQueryImp.GetCommentPaths(ctx, commentIDs)
+	if err != nil {
+		return make([]string, len(commentIDs)), batchError(len(commentIDs), err)
+	}
+
+	values := make([]string, len(commentIDs))
+	loadErrs := make([]error, len(commentIDs))
+	for i, id := range commentIDs {
+		path, ok := paths[id]
+		if !ok {
+			loadErrs[i] = errs.ErrCommentsNotExist
+			continue
+		}
+		values[i] = path
+	}
+	return values, loadErrs
+}
+
+func (h *CommentQuery) loadBranches(ctx context.Context, keys []model.BranchKey) ([][]*model.Comment, []error) {
+	branches, err := h.commentQueryImp.GetCommentsBranches(ctx, keys)
+	if err != nil {
+		return make([][]*model.Comment, len(keys)), batchError(len(keys), err)
+	}
+
+	values := make([][]*model.Comment, len(keys))
+	loadErrs := make([]error, len(keys))
+	for i, key := range keys {
+		comments, ok := branches[key]
+		if !ok {
+			if key.Path == "" {
+				loadErrs[i] = errs.ErrCommentsNotExist
+			} else {
+				loadErrs[i] = errs.ErrPathNotExist
+			}
+			continue
+		}
+		values[i] = comments
+	}
+	return values, loadErrs
+}
+
+// loadVotes loads the viewer's votes, the viewer is the same for the whole
+// request, so it is taken from the context of the batch.
+func (h *CommentQuery) loadVotes(ctx context.Context, commentIDs []int64) ([]model.VoteValue, []error) {
+	values := make([]model.VoteValue, len(commentIDs))
+
+	userID, ok := viewer.FromContext(ctx)
+	if !ok {
+		return values, nil
+	}
+
+	votes, err := h.commentQueryImp.GetCommentVotes(ctx, userID, commentIDs)
+	if err != nil {
+		return values, batchError(len(commentIDs), err)
+	}
+
+	for i, id := range commentIDs {
+		values[i] = votes[id]
+	}
+	return values, nil
+}
+
+func batchError(n int, err error) []error {
+	loadErrs := make([]error, n)
+	for i := range loadErrs {
+		loadErrs[i] = err
+	}
+	return loadErrs
+}
@@ -1,12 +1,14 @@
 package commentquery
 
 import (
-	"fmt"
-
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
 	"github.com/rs/zerolog/log"
 )
 
@@ -18,12 +20,18 @@ func NewCommentQuery(commentQueryImp CommentQueryImp) *CommentQuery {
 	return &CommentQuery{commentQueryImp: commentQueryImp}
 }
 
-func (h *CommentQuery) GetCommentsBranchToPost(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
+func (h *CommentQuery) GetCommentsBranchToPost(ctx context.Context, postID int64, path string, order model.CommentOrder) ([]*model.Comment, error) {
 	op := "internal.handlers.commentquery.GetCommentsBranchToPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	comments, err := h.commentQueryImp.GetCommentsBranch(ctx, postID, path)
+	var comments []*model.Comment
+	var err error
+	if l := loadersFromContext(ctx); l != nil {
+		comments, err = l.branches.Load(ctx, model.BranchKey{PostID: postID, Path: path})
+	} else {
+		comments, err = h.commentQueryImp.GetCommentsBranch(ctx, postID, path)
+	}
 	if err != nil {
 		if err == errs.ErrCommentsNotExist || err == errs.ErrPathNotExist {
 			return nil, err
@@ -31,16 +39,37 @@ func (h *CommentQuery) GetCommentsBranchToPost(ctx context.Context, postID int64
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	if order == model.CommentOrderTop {
+		comments = sortTop(comments)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return comments, nil
 }
 
+// sortTop returns the copy of the branch with the highest scores first, equal
+// comments keep the stored order. The branch itself may be shared with the
+// storage and the cache, so it isn't sorted in place.
+func sortTop(comments []*model.Comment) []*model.Comment {
+	comments = slices.Clone(comments)
+	slices.SortStableFunc(comments, func(a, b *model.Comment) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	return comments
+}
+
 func (h *CommentQuery) GetPathToComments(ctx context.Context, parentID int64) (string, error) {
 	op := "internal.handlers.commentquery.GetPathToComments()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	path, err := h.commentQueryImp.GetCommentPath(ctx, parentID)
+	var path string
+	var err error
+	if l := loadersFromContext(ctx); l != nil {
+		path, err = l.paths.Load(ctx, parentID)
+	} else {
+		path, err = h.commentQueryImp.GetCommentPath(ctx, parentID)
+	}
 	if err != nil {
 		if err == errs.ErrCommentsNotExist {
 			return "", err
@@ -48,7 +77,34 @@ func (h *CommentQuery) GetPathToComments(ctx context.Context, parentID int64) (s
 		return "", fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	return path, nil
 }
+
+// GetMyVote returns the vote of the viewer for the comment, ok is false when
+// the request is anonymous.
+func (h *CommentQuery) GetMyVote(ctx context.Context, commentID int64) (vote model.VoteValue, ok bool, err error) {
+	op := "internal.handlers.commentquery.GetMyVote()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	userID, ok := viewer.FromContext(ctx)
+	if !ok {
+		return model.VoteNone, false, nil
+	}
+
+	if l := loadersFromContext(ctx); l != nil {
+		vote, err = l.votes.Load(ctx, commentID)
+	} else {
+		var votes map[int64]model.VoteValue
+		votes, err = h.commentQueryImp.GetCommentVotes(ctx, userID, []int64{commentID})
+		vote = votes[commentID]
+	}
+	if err != nil {
+		return model.VoteNone, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return vote, true, nil
+}
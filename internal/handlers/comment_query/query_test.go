@@ -3,6 +3,7 @@ package commentquery
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
@@ -23,7 +24,7 @@ func TestCommentQuery(t *testing.T) {
 		path := "1.2.3"
 
 		commentQueryImpMock.GetCommentsBranchMock.Expect(ctx, postID, path).Return([]*model.Comment{}, nil)
-		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path)
+		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path, model.CommentOrderOldest)
 		assert.NoError(t, err)
 		assert.NotNil(t, comments)
 	})
@@ -34,7 +35,7 @@ func TestCommentQuery(t *testing.T) {
 		path := "1.2.3"
 
 		commentQueryImpMock.GetCommentsBranchMock.Expect(ctx, postID, path).Return(nil, errs.ErrCommentsNotExist)
-		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path)
+		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path, model.CommentOrderOldest)
 		assert.Nil(t, comments)
 		assert.Equal(t, err, errs.ErrCommentsNotExist)
 	})
@@ -45,7 +46,7 @@ func TestCommentQuery(t *testing.T) {
 		path := "1.2;l.3" // invalid path
 
 		commentQueryImpMock.GetCommentsBranchMock.Expect(ctx, postID, path).Return(nil, errs.ErrPathNotExist)
-		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path)
+		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path, model.CommentOrderOldest)
 		assert.Nil(t, comments)
 		assert.Equal(t, err, errs.ErrPathNotExist)
 	})
@@ -56,11 +57,28 @@ func TestCommentQuery(t *testing.T) {
 		path := "1.2.3"
 
 		commentQueryImpMock.GetCommentsBranchMock.Expect(ctx, postID, path).Return(nil, errors.New("unexpected error"))
-		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path)
+		comments, err := handler.GetCommentsBranchToPost(ctx, postID, path, model.CommentOrderOldest)
 		assert.NotNil(t, err)
 		assert.Nil(t, comments)
 	})
 
+	t.Run("Top order puts the highest score first", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(1)
+		branch := []*model.Comment{{ID: 1, Score: 0}, {ID: 2, Score: 5}, {ID: 3, Score: -1}, {ID: 4, Score: 5}}
+
+		commentQueryImpMock.GetCommentsBranchMock.Expect(ctx, postID, "").Return(branch, nil)
+		comments, err := handler.GetCommentsBranchToPost(ctx, postID, "", model.CommentOrderTop)
+		assert.NoError(t, err)
+
+		ids := make([]int64, len(comments))
+		for i, c := range comments {
+			ids[i] = c.ID
+		}
+		assert.Equal(t, []int64{2, 4, 1, 3}, ids)
+		assert.Equal(t, int64(1), branch[0].ID, "the stored branch keeps its order")
+	})
+
 	t.Run("Successfully get path to  comments", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
@@ -92,3 +110,80 @@ func TestCommentQuery(t *testing.T) {
 	})
 
 }
+
+func TestCommentQueryLoaders(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	commentQueryImpMock := NewCommentQueryImpMock(mc)
+	handler := CommentQuery{commentQueryImp: commentQueryImpMock}
+
+	t.Run("Branches are loaded in one batch", func(t *testing.T) {
+		ctx := handler.WithLoaders(context.Background())
+		replies := []*model.Comment{{ID: 2, PostID: 1, Path: "1.2"}}
+
+		commentQueryImpMock.GetCommentsBranchesMock.Set(func(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error) {
+			assert.ElementsMatch(t, []model.BranchKey{{PostID: 1, Path: "1"}, {PostID: 1, Path: "3"}, {PostID: 2}}, keys)
+			return map[model.BranchKey][]*model.Comment{{PostID: 1, Path: "1"}: replies}, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			comments, err := handler.GetCommentsBranchToPost(ctx, 1, "1", model.CommentOrderOldest)
+			assert.NoError(t, err)
+			assert.Equal(t, replies, comments)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := handler.GetCommentsBranchToPost(ctx, 1, "3", model.CommentOrderOldest)
+			assert.Equal(t, errs.ErrPathNotExist, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := handler.GetCommentsBranchToPost(ctx, 2, "", model.CommentOrderOldest)
+			assert.Equal(t, errs.ErrCommentsNotExist, err)
+		}()
+		wg.Wait()
+
+		assert.Equal(t, uint64(1), commentQueryImpMock.GetCommentsBranchesAfterCounter())
+	})
+
+	t.Run("Paths are loaded in one batch", func(t *testing.T) {
+		ctx := handler.WithLoaders(context.Background())
+
+		commentQueryImpMock.GetCommentPathsMock.Set(func(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+			assert.ElementsMatch(t, []int64{1, 5}, commentIDs)
+			return map[int64]string{1: "1"}, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			path, err := handler.GetPathToComments(ctx, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, "1", path)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := handler.GetPathToComments(ctx, 5)
+			assert.Equal(t, errs.ErrCommentsNotExist, err)
+		}()
+		wg.Wait()
+
+		assert.Equal(t, uint64(1), commentQueryImpMock.GetCommentPathsAfterCounter())
+	})
+
+	t.Run("Storage error is returned for every key", func(t *testing.T) {
+		ctx := handler.WithLoaders(context.Background())
+
+		commentQueryImpMock.GetCommentPathsMock.Set(func(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+			return nil, errors.New("unexpected error")
+		})
+
+		path, err := handler.GetPathToComments(ctx, 7)
+		assert.NotNil(t, err)
+		assert.Equal(t, "", path)
+	})
+}
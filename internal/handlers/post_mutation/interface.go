@@ -2,6 +2,7 @@ package postmutation
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
@@ -11,4 +12,8 @@ import (
 type PostMutImp interface {
 	AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error)
 	UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error)
+	VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error)
+	PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error)
+	SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error)
+	PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error)
 }
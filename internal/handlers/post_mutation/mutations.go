@@ -3,40 +3,74 @@ package postmutation
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
 	"github.com/rs/zerolog/log"
 )
 
 type PostMutation struct {
 	postMutImp PostMutImp
+	limits     validate.Limits
 }
 
-func NewPostMutation(postImp PostMutImp) *PostMutation {
-	return &PostMutation{postMutImp: postImp}
+// NewPostMutation returns the handler checking the titles and the texts
+// against the limits.
+func NewPostMutation(postImp PostMutImp, limits validate.Limits) *PostMutation {
+	return &PostMutation{postMutImp: postImp, limits: limits}
 }
 
 func (h *PostMutation) AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error) {
 	op := "internal.handlers.postmutation.AddPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	post, err := h.postMutImp.AddPost(ctx, newPost)
+	normalized := *newPost
+	var v validate.Validator
+	v.Author("authorID", newPost.AuthorID)
+	normalized.Title = v.Line("title", newPost.Title, h.limits.TitleMaxLength)
+	normalized.Text = v.Text("text", newPost.Text, h.limits.PostTextMaxLength)
+	normalized.Tags = normalizeTags(&v, newPost.Tags)
+	if newPost.MaxCommentDepth != nil && *newPost.MaxCommentDepth < 0 {
+		v.Add("maxCommentDepth", "must not be negative")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if normalized.Status == "" {
+		normalized.Status = model.PostPublished
+	}
+	if (normalized.Status == model.PostScheduled) != (normalized.PublishAt != nil) {
+		return nil, errs.ErrInvalidPublishAt
+	}
+	if normalized.PublishAt != nil && !normalized.PublishAt.After(time.Now()) {
+		return nil, errs.ErrInvalidPublishAt
+	}
+
+	post, err := h.postMutImp.AddPost(ctx, &normalized)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, nil
 }
 
 func (h *PostMutation) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
 	op := "internal.handlers.postmutation.UpdateEnableCommentToPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(authorID); err != nil {
+		return nil, err
+	}
 
 	post, err := h.postMutImp.UpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
 
@@ -47,6 +81,124 @@ func (h *PostMutation) UpdateEnableCommentToPost(ctx context.Context, postID int
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, nil
 }
+
+// PublishPost publishes the draft or the scheduled post of the author right away.
+func (h *PostMutation) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	op := "internal.handlers.postmutation.PublishPost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(authorID); err != nil {
+		return nil, err
+	}
+
+	post, err := h.postMutImp.PublishPost(ctx, postID, authorID)
+
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrUnauthorizedAccess || err == errs.ErrPostAlreadyPublished {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// SchedulePost schedules the draft of the author, or reschedules the scheduled
+// post, to be published at publishAt.
+func (h *PostMutation) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	op := "internal.handlers.postmutation.SchedulePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(authorID); err != nil {
+		return nil, err
+	}
+
+	if !publishAt.After(time.Now()) {
+		return nil, errs.ErrInvalidPublishAt
+	}
+
+	post, err := h.postMutImp.SchedulePost(ctx, postID, authorID, publishAt)
+
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrUnauthorizedAccess || err == errs.ErrPostAlreadyPublished {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// PublishDuePosts publishes the scheduled posts whose time has come by now and
+// returns them.
+func (h *PostMutation) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	op := "internal.handlers.postmutation.PublishDuePosts()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	posts, err := h.postMutImp.PublishDuePosts(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return posts, nil
+}
+
+func (h *PostMutation) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	op := "internal.handlers.postmutation.VotePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(userID); err != nil {
+		return nil, err
+	}
+	if value < model.VoteDown || value > model.VoteUp {
+		return nil, errs.ErrInvalidVote
+	}
+
+	post, err := h.postMutImp.VotePost(ctx, postID, userID, value)
+
+	if err != nil {
+		if err == errs.ErrPostNotExist {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// normalizeTags normalizes the tags and drops the repeated ones, a tag that is
+// empty or too long after the normalization is a problem of its field.
+func normalizeTags(v *validate.Validator, tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		tag = model.NormalizeTag(tag)
+		field := fmt.Sprintf("tags[%d]", i)
+		switch {
+		case tag == "":
+			v.Add(field, "must not be empty")
+		case utf8.RuneCountInString(tag) > model.MaxTagLength:
+			v.Add(field, fmt.Sprintf("must be at most %d characters", model.MaxTagLength))
+		case !slices.Contains(normalized, tag):
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > model.MaxPostTags {
+		v.Add("tags", fmt.Sprintf("must be at most %d different tags", model.MaxPostTags))
+	}
+	return normalized
+}
@@ -3,20 +3,29 @@ package postmutation
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
 	"github.com/stretchr/testify/assert"
 )
 
+var testLimits = validate.Limits{
+	TitleMaxLength:       200,
+	PostTextMaxLength:    50000,
+	CommentTextMaxLength: 2000,
+}
+
 func TestPostMutation(t *testing.T) {
 	mc := minimock.NewController(t)
 
 	postMutImpMock := NewPostMutImpMock(mc)
-	handler := PostMutation{postMutImp: postMutImpMock}
+	handler := PostMutation{postMutImp: postMutImpMock, limits: testLimits}
 
 	t.Run("Succesfully add post", func(t *testing.T) {
 		ctx := context.Background()
@@ -25,6 +34,7 @@ func TestPostMutation(t *testing.T) {
 			Title:           "Test Title",
 			Text:            "Test Content",
 			CommentsEnabled: true,
+			Status:          model.PostPublished,
 		}
 		expectedPost := &model.Post{
 			ID:              1,
@@ -47,6 +57,7 @@ func TestPostMutation(t *testing.T) {
 			Title:           "Test Title",
 			Text:            "Test Content",
 			CommentsEnabled: true,
+			Status:          model.PostPublished,
 		}
 
 		postMutImpMock.AddPostMock.Expect(ctx, newPost).Return(nil, errors.New("unexpected error"))
@@ -55,6 +66,78 @@ func TestPostMutation(t *testing.T) {
 		assert.Nil(t, post)
 	})
 
+	t.Run("Tags are normalized and deduplicated", func(t *testing.T) {
+		ctx := context.Background()
+		authorID := uuid.New()
+		newPost := &model.NewPost{AuthorID: authorID, Title: "Test Title", Text: "Test Content", Tags: []string{" Go ", "go", "Habr  API"}}
+
+		postMutImpMock.AddPostMock.Expect(ctx, &model.NewPost{AuthorID: authorID, Title: "Test Title", Text: "Test Content", Tags: []string{"go", "habr api"}, Status: model.PostPublished}).
+			Return(&model.Post{ID: 1}, nil)
+		post, err := handler.AddPost(ctx, newPost)
+		assert.NoError(t, err)
+		assert.NotNil(t, post)
+		assert.Equal(t, []string{" Go ", "go", "Habr  API"}, newPost.Tags, "the input isn't modified")
+	})
+
+	t.Run("Error invalid tags", func(t *testing.T) {
+		for _, tt := range []struct {
+			tags  []string
+			field errs.FieldError
+		}{
+			{[]string{"go", "   "}, errs.FieldError{Field: "tags[1]", Message: "must not be empty"}},
+			{[]string{strings.Repeat("т", model.MaxTagLength+1)}, errs.FieldError{Field: "tags[0]", Message: "must be at most 32 characters"}},
+			{[]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}, errs.FieldError{Field: "tags", Message: "must be at most 10 different tags"}},
+		} {
+			post, err := handler.AddPost(context.Background(), &model.NewPost{AuthorID: uuid.New(), Title: "t", Text: "t", Tags: tt.tags})
+			assert.Equal(t, &errs.ValidationError{Fields: []errs.FieldError{tt.field}}, err)
+			assert.Nil(t, post)
+		}
+	})
+
+	t.Run("Title and text are trimmed", func(t *testing.T) {
+		ctx := context.Background()
+		authorID := uuid.New()
+		newPost := &model.NewPost{AuthorID: authorID, Title: "  Test Title\t", Text: "\nTest Content\n\n"}
+
+		postMutImpMock.AddPostMock.Expect(ctx, &model.NewPost{AuthorID: authorID, Title: "Test Title", Text: "Test Content", Status: model.PostPublished}).
+			Return(&model.Post{ID: 1}, nil)
+		post, err := handler.AddPost(ctx, newPost)
+		assert.NoError(t, err)
+		assert.NotNil(t, post)
+	})
+
+	t.Run("Error invalid input", func(t *testing.T) {
+		post, err := handler.AddPost(context.Background(), &model.NewPost{
+			Title: "Test\nTitle",
+			Text:  strings.Repeat("t", testLimits.PostTextMaxLength+1),
+		})
+		assert.Equal(t, &errs.ValidationError{Fields: []errs.FieldError{
+			{Field: "authorID", Message: "must not be nil UUID"},
+			{Field: "title", Message: "must not contain control characters"},
+			{Field: "text", Message: "must be at most 50000 characters"},
+		}}, err)
+		assert.Nil(t, post)
+
+		negative := -1
+		for _, newPost := range []*model.NewPost{
+			{AuthorID: uuid.New(), Title: " ", Text: "t"},
+			{AuthorID: uuid.New(), Title: strings.Repeat("т", testLimits.TitleMaxLength+1), Text: "t"},
+			{AuthorID: uuid.New(), Title: "t", Text: "\xff"},
+			{AuthorID: uuid.New(), Title: "t", Text: ""},
+			{AuthorID: uuid.New(), Title: "t", Text: "t", MaxCommentDepth: &negative},
+		} {
+			post, err := handler.AddPost(context.Background(), newPost)
+			assert.ErrorIs(t, err, errs.ErrInvalidInput)
+			assert.Nil(t, post)
+		}
+	})
+
+	t.Run("Error nil author", func(t *testing.T) {
+		post, err := handler.UpdateEnableCommentToPost(context.Background(), 1, uuid.Nil, true)
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		assert.Nil(t, post)
+	})
+
 	t.Run("Succesfully update enable comment to post", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(1)
@@ -109,4 +192,124 @@ func TestPostMutation(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, post)
 	})
+
+	t.Run("Successfully vote post", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(1)
+		userID := uuid.New()
+		expectedPost := &model.Post{ID: postID, Score: 1, Upvotes: 1}
+
+		postMutImpMock.VotePostMock.Expect(ctx, postID, userID, model.VoteUp).Return(expectedPost, nil)
+		post, err := handler.VotePost(ctx, postID, userID, model.VoteUp)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPost, post)
+	})
+
+	t.Run("Error invalid vote value", func(t *testing.T) {
+		ctx := context.Background()
+
+		post, err := handler.VotePost(ctx, 1, uuid.New(), model.VoteValue(2))
+		assert.Equal(t, errs.ErrInvalidVote, err)
+		assert.Nil(t, post)
+	})
+
+	t.Run("Error vote for post that not exist", func(t *testing.T) {
+		ctx := context.Background()
+		postID := int64(123)
+		userID := uuid.New()
+
+		postMutImpMock.VotePostMock.Expect(ctx, postID, userID, model.VoteDown).Return(nil, errs.ErrPostNotExist)
+		post, err := handler.VotePost(ctx, postID, userID, model.VoteDown)
+		assert.Equal(t, errs.ErrPostNotExist, err)
+		assert.Nil(t, post)
+	})
+}
+
+func TestPostMutationPublishing(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	postMutImpMock := NewPostMutImpMock(mc)
+	handler := PostMutation{postMutImp: postMutImpMock, limits: testLimits}
+
+	authorID := uuid.New()
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("Successfully add scheduled post", func(t *testing.T) {
+		ctx := context.Background()
+		newPost := &model.NewPost{AuthorID: authorID, Title: "t", Text: "t", Status: model.PostScheduled, PublishAt: &future}
+
+		postMutImpMock.AddPostMock.Expect(ctx, newPost).Return(&model.Post{ID: 1, Status: model.PostScheduled}, nil)
+		post, err := handler.AddPost(ctx, newPost)
+		assert.NoError(t, err)
+		assert.Equal(t, model.PostScheduled, post.Status)
+	})
+
+	t.Run("Error invalid publishAt", func(t *testing.T) {
+		for _, newPost := range []*model.NewPost{
+			{AuthorID: authorID, Title: "t", Text: "t", Status: model.PostScheduled},
+			{AuthorID: authorID, Title: "t", Text: "t", Status: model.PostScheduled, PublishAt: &past},
+			{AuthorID: authorID, Title: "t", Text: "t", Status: model.PostDraft, PublishAt: &future},
+			{AuthorID: authorID, Title: "t", Text: "t", PublishAt: &future},
+		} {
+			post, err := handler.AddPost(context.Background(), newPost)
+			assert.Equal(t, errs.ErrInvalidPublishAt, err)
+			assert.Nil(t, post)
+		}
+	})
+
+	t.Run("Successfully publish post", func(t *testing.T) {
+		ctx := context.Background()
+		expectedPost := &model.Post{ID: 1, AuthorID: authorID, Status: model.PostPublished}
+
+		postMutImpMock.PublishPostMock.Expect(ctx, int64(1), authorID).Return(expectedPost, nil)
+		post, err := handler.PublishPost(ctx, 1, authorID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPost, post)
+	})
+
+	t.Run("Error post already published", func(t *testing.T) {
+		ctx := context.Background()
+
+		postMutImpMock.PublishPostMock.Expect(ctx, int64(2), authorID).Return(nil, errs.ErrPostAlreadyPublished)
+		post, err := handler.PublishPost(ctx, 2, authorID)
+		assert.Equal(t, errs.ErrPostAlreadyPublished, err)
+		assert.Nil(t, post)
+	})
+
+	t.Run("Successfully schedule post", func(t *testing.T) {
+		ctx := context.Background()
+		expectedPost := &model.Post{ID: 1, AuthorID: authorID, Status: model.PostScheduled, PublishAt: &future}
+
+		postMutImpMock.SchedulePostMock.Expect(ctx, int64(1), authorID, future).Return(expectedPost, nil)
+		post, err := handler.SchedulePost(ctx, 1, authorID, future)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPost, post)
+	})
+
+	t.Run("Error schedule post in the past", func(t *testing.T) {
+		post, err := handler.SchedulePost(context.Background(), 1, authorID, past)
+		assert.Equal(t, errs.ErrInvalidPublishAt, err)
+		assert.Nil(t, post)
+	})
+
+	t.Run("Error schedule post of another author", func(t *testing.T) {
+		ctx := context.Background()
+		otherID := uuid.New()
+
+		postMutImpMock.SchedulePostMock.Expect(ctx, int64(1), otherID, future).Return(nil, errs.ErrPostNotExist)
+		post, err := handler.SchedulePost(ctx, 1, otherID, future)
+		assert.Equal(t, errs.ErrPostNotExist, err)
+		assert.Nil(t, post)
+	})
+
+	t.Run("Unexpected error from publish due posts", func(t *testing.T) {
+		ctx := context.Background()
+		now := time.Now()
+
+		postMutImpMock.PublishDuePostsMock.Expect(ctx, now).Return(nil, errors.New("unexpected error"))
+		posts, err := handler.PublishDuePosts(ctx, now)
+		assert.NotNil(t, err)
+		assert.Nil(t, posts)
+	})
 }
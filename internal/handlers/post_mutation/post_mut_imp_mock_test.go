@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -27,12 +28,40 @@ type PostMutImpMock struct {
 	beforeAddPostCounter uint64
 	AddPostMock          mPostMutImpMockAddPost
 
+	funcPublishDuePosts          func(ctx context.Context, now time.Time) (ppa1 []*model.Post, err error)
+	funcPublishDuePostsOrigin    string
+	inspectFuncPublishDuePosts   func(ctx context.Context, now time.Time)
+	afterPublishDuePostsCounter  uint64
+	beforePublishDuePostsCounter uint64
+	PublishDuePostsMock          mPostMutImpMockPublishDuePosts
+
+	funcPublishPost          func(ctx context.Context, postID int64, authorID uuid.UUID) (pp1 *model.Post, err error)
+	funcPublishPostOrigin    string
+	inspectFuncPublishPost   func(ctx context.Context, postID int64, authorID uuid.UUID)
+	afterPublishPostCounter  uint64
+	beforePublishPostCounter uint64
+	PublishPostMock          mPostMutImpMockPublishPost
+
+	funcSchedulePost          func(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (pp1 *model.Post, err error)
+	funcSchedulePostOrigin    string
+	inspectFuncSchedulePost   func(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time)
+	afterSchedulePostCounter  uint64
+	beforeSchedulePostCounter uint64
+	SchedulePostMock          mPostMutImpMockSchedulePost
+
 	funcUpdateEnableCommentToPost          func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (pp1 *model.Post, err error)
 	funcUpdateEnableCommentToPostOrigin    string
 	inspectFuncUpdateEnableCommentToPost   func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool)
 	afterUpdateEnableCommentToPostCounter  uint64
 	beforeUpdateEnableCommentToPostCounter uint64
 	UpdateEnableCommentToPostMock          mPostMutImpMockUpdateEnableCommentToPost
+
+	funcVotePost          func(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (pp1 *model.Post, err error)
+	funcVotePostOrigin    string
+	inspectFuncVotePost   func(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue)
+	afterVotePostCounter  uint64
+	beforeVotePostCounter uint64
+	VotePostMock          mPostMutImpMockVotePost
 }
 
 // NewPostMutImpMock returns a mock for PostMutImp
@@ -46,9 +75,21 @@ func NewPostMutImpMock(t minimock.Tester) *PostMutImpMock {
 	m.AddPostMock = mPostMutImpMockAddPost{mock: m}
 	m.AddPostMock.callArgs = []*PostMutImpMockAddPostParams{}
 
+	m.PublishDuePostsMock = mPostMutImpMockPublishDuePosts{mock: m}
+	m.PublishDuePostsMock.callArgs = []*PostMutImpMockPublishDuePostsParams{}
+
+	m.PublishPostMock = mPostMutImpMockPublishPost{mock: m}
+	m.PublishPostMock.callArgs = []*PostMutImpMockPublishPostParams{}
+
+	m.SchedulePostMock = mPostMutImpMockSchedulePost{mock: m}
+	m.SchedulePostMock.callArgs = []*PostMutImpMockSchedulePostParams{}
+
 	m.UpdateEnableCommentToPostMock = mPostMutImpMockUpdateEnableCommentToPost{mock: m}
 	m.UpdateEnableCommentToPostMock.callArgs = []*PostMutImpMockUpdateEnableCommentToPostParams{}
 
+	m.VotePostMock = mPostMutImpMockVotePost{mock: m}
+	m.VotePostMock.callArgs = []*PostMutImpMockVotePostParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -397,59 +438,53 @@ func (m *PostMutImpMock) MinimockAddPostInspect() {
 	}
 }
 
-type mPostMutImpMockUpdateEnableCommentToPost struct {
+type mPostMutImpMockPublishDuePosts struct {
 	optional           bool
 	mock               *PostMutImpMock
-	defaultExpectation *PostMutImpMockUpdateEnableCommentToPostExpectation
-	expectations       []*PostMutImpMockUpdateEnableCommentToPostExpectation
+	defaultExpectation *PostMutImpMockPublishDuePostsExpectation
+	expectations       []*PostMutImpMockPublishDuePostsExpectation
 
-	callArgs []*PostMutImpMockUpdateEnableCommentToPostParams
+	callArgs []*PostMutImpMockPublishDuePostsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PostMutImpMockUpdateEnableCommentToPostExpectation specifies expectation struct of the PostMutImp.UpdateEnableCommentToPost
-type PostMutImpMockUpdateEnableCommentToPostExpectation struct {
+// PostMutImpMockPublishDuePostsExpectation specifies expectation struct of the PostMutImp.PublishDuePosts
+type PostMutImpMockPublishDuePostsExpectation struct {
 	mock               *PostMutImpMock
-	params             *PostMutImpMockUpdateEnableCommentToPostParams
-	paramPtrs          *PostMutImpMockUpdateEnableCommentToPostParamPtrs
-	expectationOrigins PostMutImpMockUpdateEnableCommentToPostExpectationOrigins
-	results            *PostMutImpMockUpdateEnableCommentToPostResults
+	params             *PostMutImpMockPublishDuePostsParams
+	paramPtrs          *PostMutImpMockPublishDuePostsParamPtrs
+	expectationOrigins PostMutImpMockPublishDuePostsExpectationOrigins
+	results            *PostMutImpMockPublishDuePostsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PostMutImpMockUpdateEnableCommentToPostParams contains parameters of the PostMutImp.UpdateEnableCommentToPost
-type PostMutImpMockUpdateEnableCommentToPostParams struct {
-	ctx             context.Context
-	postID          int64
-	authorID        uuid.UUID
-	commentsEnabled bool
+// PostMutImpMockPublishDuePostsParams contains parameters of the PostMutImp.PublishDuePosts
+type PostMutImpMockPublishDuePostsParams struct {
+	ctx context.Context
+	now time.Time
 }
 
-// PostMutImpMockUpdateEnableCommentToPostParamPtrs contains pointers to parameters of the PostMutImp.UpdateEnableCommentToPost
-type PostMutImpMockUpdateEnableCommentToPostParamPtrs struct {
-	ctx             *context.Context
-	postID          *int64
-	authorID        *uuid.UUID
-	commentsEnabled *bool
+// PostMutImpMockPublishDuePostsParamPtrs contains pointers to parameters of the PostMutImp.PublishDuePosts
+type PostMutImpMockPublishDuePostsParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
 }
 
-// PostMutImpMockUpdateEnableCommentToPostResults contains results of the PostMutImp.UpdateEnableCommentToPost
-type PostMutImpMockUpdateEnableCommentToPostResults struct {
-	pp1 *model.Post
-	err error
+// PostMutImpMockPublishDuePostsResults contains results of the PostMutImp.PublishDuePosts
+type PostMutImpMockPublishDuePostsResults struct {
+	ppa1 []*model.Post
+	err  error
 }
 
-// PostMutImpMockUpdateEnableCommentToPostOrigins contains origins of expectations of the PostMutImp.UpdateEnableCommentToPost
-type PostMutImpMockUpdateEnableCommentToPostExpectationOrigins struct {
-	origin                string
-	originCtx             string
-	originPostID          string
-	originAuthorID        string
-	originCommentsEnabled string
+// PostMutImpMockPublishDuePostsOrigins contains origins of expectations of the PostMutImp.PublishDuePosts
+type PostMutImpMockPublishDuePostsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originNow string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -457,358 +492,1899 @@ type PostMutImpMockUpdateEnableCommentToPostExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Optional() *mPostMutImpMockUpdateEnableCommentToPost {
-	mmUpdateEnableCommentToPost.optional = true
-	return mmUpdateEnableCommentToPost
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Optional() *mPostMutImpMockPublishDuePosts {
+	mmPublishDuePosts.optional = true
+	return mmPublishDuePosts
 }
 
-// Expect sets up expected params for PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Expect(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+// Expect sets up expected params for PostMutImp.PublishDuePosts
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Expect(ctx context.Context, now time.Time) *mPostMutImpMockPublishDuePosts {
+	if mmPublishDuePosts.mock.funcPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Set")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	if mmPublishDuePosts.defaultExpectation == nil {
+		mmPublishDuePosts.defaultExpectation = &PostMutImpMockPublishDuePostsExpectation{}
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by ExpectParams functions")
+	if mmPublishDuePosts.defaultExpectation.paramPtrs != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateEnableCommentToPost.defaultExpectation.params = &PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
-	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateEnableCommentToPost.expectations {
-		if minimock.Equal(e.params, mmUpdateEnableCommentToPost.defaultExpectation.params) {
-			mmUpdateEnableCommentToPost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEnableCommentToPost.defaultExpectation.params)
+	mmPublishDuePosts.defaultExpectation.params = &PostMutImpMockPublishDuePostsParams{ctx, now}
+	mmPublishDuePosts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublishDuePosts.expectations {
+		if minimock.Equal(e.params, mmPublishDuePosts.defaultExpectation.params) {
+			mmPublishDuePosts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishDuePosts.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateEnableCommentToPost
-}
-
-// ExpectCtxParam1 sets up expected param ctx for PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
-	}
-	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmUpdateEnableCommentToPost
-}
-
-// ExpectPostIDParam2 sets up expected param postID for PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectPostIDParam2(postID int64) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
-	}
-
-	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
-	}
-	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.postID = &postID
-	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
-
-	return mmUpdateEnableCommentToPost
+	return mmPublishDuePosts
 }
 
-// ExpectAuthorIDParam3 sets up expected param authorID for PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectAuthorIDParam3(authorID uuid.UUID) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for PostMutImp.PublishDuePosts
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockPublishDuePosts {
+	if mmPublishDuePosts.mock.funcPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Set")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	if mmPublishDuePosts.defaultExpectation == nil {
+		mmPublishDuePosts.defaultExpectation = &PostMutImpMockPublishDuePostsExpectation{}
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	if mmPublishDuePosts.defaultExpectation.params != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Expect")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	if mmPublishDuePosts.defaultExpectation.paramPtrs == nil {
+		mmPublishDuePosts.defaultExpectation.paramPtrs = &PostMutImpMockPublishDuePostsParamPtrs{}
 	}
-	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.authorID = &authorID
-	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+	mmPublishDuePosts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublishDuePosts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateEnableCommentToPost
+	return mmPublishDuePosts
 }
 
-// ExpectCommentsEnabledParam4 sets up expected param commentsEnabled for PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectCommentsEnabledParam4(commentsEnabled bool) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+// ExpectNowParam2 sets up expected param now for PostMutImp.PublishDuePosts
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) ExpectNowParam2(now time.Time) *mPostMutImpMockPublishDuePosts {
+	if mmPublishDuePosts.mock.funcPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Set")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	if mmPublishDuePosts.defaultExpectation == nil {
+		mmPublishDuePosts.defaultExpectation = &PostMutImpMockPublishDuePostsExpectation{}
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	if mmPublishDuePosts.defaultExpectation.params != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Expect")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	if mmPublishDuePosts.defaultExpectation.paramPtrs == nil {
+		mmPublishDuePosts.defaultExpectation.paramPtrs = &PostMutImpMockPublishDuePostsParamPtrs{}
 	}
-	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.commentsEnabled = &commentsEnabled
-	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originCommentsEnabled = minimock.CallerInfo(1)
+	mmPublishDuePosts.defaultExpectation.paramPtrs.now = &now
+	mmPublishDuePosts.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
 
-	return mmUpdateEnableCommentToPost
+	return mmPublishDuePosts
 }
 
-// Inspect accepts an inspector function that has same arguments as the PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Inspect(f func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool)) *mPostMutImpMockUpdateEnableCommentToPost {
-	if mmUpdateEnableCommentToPost.mock.inspectFuncUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.UpdateEnableCommentToPost")
+// Inspect accepts an inspector function that has same arguments as the PostMutImp.PublishDuePosts
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Inspect(f func(ctx context.Context, now time.Time)) *mPostMutImpMockPublishDuePosts {
+	if mmPublishDuePosts.mock.inspectFuncPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.PublishDuePosts")
 	}
 
-	mmUpdateEnableCommentToPost.mock.inspectFuncUpdateEnableCommentToPost = f
+	mmPublishDuePosts.mock.inspectFuncPublishDuePosts = f
 
-	return mmUpdateEnableCommentToPost
+	return mmPublishDuePosts
 }
 
-// Return sets up results that will be returned by PostMutImp.UpdateEnableCommentToPost
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Return(pp1 *model.Post, err error) *PostMutImpMock {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+// Return sets up results that will be returned by PostMutImp.PublishDuePosts
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Return(ppa1 []*model.Post, err error) *PostMutImpMock {
+	if mmPublishDuePosts.mock.funcPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Set")
 	}
 
-	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
-		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{mock: mmUpdateEnableCommentToPost.mock}
+	if mmPublishDuePosts.defaultExpectation == nil {
+		mmPublishDuePosts.defaultExpectation = &PostMutImpMockPublishDuePostsExpectation{mock: mmPublishDuePosts.mock}
 	}
-	mmUpdateEnableCommentToPost.defaultExpectation.results = &PostMutImpMockUpdateEnableCommentToPostResults{pp1, err}
-	mmUpdateEnableCommentToPost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateEnableCommentToPost.mock
+	mmPublishDuePosts.defaultExpectation.results = &PostMutImpMockPublishDuePostsResults{ppa1, err}
+	mmPublishDuePosts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublishDuePosts.mock
 }
 
-// Set uses given function f to mock the PostMutImp.UpdateEnableCommentToPost method
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Set(f func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (pp1 *model.Post, err error)) *PostMutImpMock {
-	if mmUpdateEnableCommentToPost.defaultExpectation != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("Default expectation is already set for the PostMutImp.UpdateEnableCommentToPost method")
+// Set uses given function f to mock the PostMutImp.PublishDuePosts method
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Set(f func(ctx context.Context, now time.Time) (ppa1 []*model.Post, err error)) *PostMutImpMock {
+	if mmPublishDuePosts.defaultExpectation != nil {
+		mmPublishDuePosts.mock.t.Fatalf("Default expectation is already set for the PostMutImp.PublishDuePosts method")
 	}
 
-	if len(mmUpdateEnableCommentToPost.expectations) > 0 {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("Some expectations are already set for the PostMutImp.UpdateEnableCommentToPost method")
+	if len(mmPublishDuePosts.expectations) > 0 {
+		mmPublishDuePosts.mock.t.Fatalf("Some expectations are already set for the PostMutImp.PublishDuePosts method")
 	}
 
-	mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost = f
-	mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPostOrigin = minimock.CallerInfo(1)
-	return mmUpdateEnableCommentToPost.mock
+	mmPublishDuePosts.mock.funcPublishDuePosts = f
+	mmPublishDuePosts.mock.funcPublishDuePostsOrigin = minimock.CallerInfo(1)
+	return mmPublishDuePosts.mock
 }
 
-// When sets expectation for the PostMutImp.UpdateEnableCommentToPost which will trigger the result defined by the following
+// When sets expectation for the PostMutImp.PublishDuePosts which will trigger the result defined by the following
 // Then helper
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) When(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) *PostMutImpMockUpdateEnableCommentToPostExpectation {
-	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) When(ctx context.Context, now time.Time) *PostMutImpMockPublishDuePostsExpectation {
+	if mmPublishDuePosts.mock.funcPublishDuePosts != nil {
+		mmPublishDuePosts.mock.t.Fatalf("PostMutImpMock.PublishDuePosts mock is already set by Set")
 	}
 
-	expectation := &PostMutImpMockUpdateEnableCommentToPostExpectation{
-		mock:               mmUpdateEnableCommentToPost.mock,
-		params:             &PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled},
-		expectationOrigins: PostMutImpMockUpdateEnableCommentToPostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &PostMutImpMockPublishDuePostsExpectation{
+		mock:               mmPublishDuePosts.mock,
+		params:             &PostMutImpMockPublishDuePostsParams{ctx, now},
+		expectationOrigins: PostMutImpMockPublishDuePostsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateEnableCommentToPost.expectations = append(mmUpdateEnableCommentToPost.expectations, expectation)
+	mmPublishDuePosts.expectations = append(mmPublishDuePosts.expectations, expectation)
 	return expectation
 }
 
-// Then sets up PostMutImp.UpdateEnableCommentToPost return parameters for the expectation previously defined by the When method
-func (e *PostMutImpMockUpdateEnableCommentToPostExpectation) Then(pp1 *model.Post, err error) *PostMutImpMock {
-	e.results = &PostMutImpMockUpdateEnableCommentToPostResults{pp1, err}
+// Then sets up PostMutImp.PublishDuePosts return parameters for the expectation previously defined by the When method
+func (e *PostMutImpMockPublishDuePostsExpectation) Then(ppa1 []*model.Post, err error) *PostMutImpMock {
+	e.results = &PostMutImpMockPublishDuePostsResults{ppa1, err}
 	return e.mock
 }
 
-// Times sets number of times PostMutImp.UpdateEnableCommentToPost should be invoked
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Times(n uint64) *mPostMutImpMockUpdateEnableCommentToPost {
+// Times sets number of times PostMutImp.PublishDuePosts should be invoked
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Times(n uint64) *mPostMutImpMockPublishDuePosts {
 	if n == 0 {
-		mmUpdateEnableCommentToPost.mock.t.Fatalf("Times of PostMutImpMock.UpdateEnableCommentToPost mock can not be zero")
+		mmPublishDuePosts.mock.t.Fatalf("Times of PostMutImpMock.PublishDuePosts mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateEnableCommentToPost.expectedInvocations, n)
-	mmUpdateEnableCommentToPost.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateEnableCommentToPost
+	mm_atomic.StoreUint64(&mmPublishDuePosts.expectedInvocations, n)
+	mmPublishDuePosts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublishDuePosts
 }
 
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) invocationsDone() bool {
-	if len(mmUpdateEnableCommentToPost.expectations) == 0 && mmUpdateEnableCommentToPost.defaultExpectation == nil && mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost == nil {
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) invocationsDone() bool {
+	if len(mmPublishDuePosts.expectations) == 0 && mmPublishDuePosts.defaultExpectation == nil && mmPublishDuePosts.mock.funcPublishDuePosts == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.mock.afterUpdateEnableCommentToPostCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishDuePosts.mock.afterPublishDuePostsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishDuePosts.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateEnableCommentToPost implements PostMutImp
-func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (pp1 *model.Post, err error) {
-	mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.beforeUpdateEnableCommentToPostCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.afterUpdateEnableCommentToPostCounter, 1)
+// PublishDuePosts implements PostMutImp
+func (mmPublishDuePosts *PostMutImpMock) PublishDuePosts(ctx context.Context, now time.Time) (ppa1 []*model.Post, err error) {
+	mm_atomic.AddUint64(&mmPublishDuePosts.beforePublishDuePostsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishDuePosts.afterPublishDuePostsCounter, 1)
 
-	mmUpdateEnableCommentToPost.t.Helper()
+	mmPublishDuePosts.t.Helper()
 
-	if mmUpdateEnableCommentToPost.inspectFuncUpdateEnableCommentToPost != nil {
-		mmUpdateEnableCommentToPost.inspectFuncUpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	if mmPublishDuePosts.inspectFuncPublishDuePosts != nil {
+		mmPublishDuePosts.inspectFuncPublishDuePosts(ctx, now)
 	}
 
-	mm_params := PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
+	mm_params := PostMutImpMockPublishDuePostsParams{ctx, now}
 
 	// Record call args
-	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.mutex.Lock()
-	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.callArgs = append(mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.callArgs, &mm_params)
-	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.mutex.Unlock()
+	mmPublishDuePosts.PublishDuePostsMock.mutex.Lock()
+	mmPublishDuePosts.PublishDuePostsMock.callArgs = append(mmPublishDuePosts.PublishDuePostsMock.callArgs, &mm_params)
+	mmPublishDuePosts.PublishDuePostsMock.mutex.Unlock()
 
-	for _, e := range mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.expectations {
+	for _, e := range mmPublishDuePosts.PublishDuePostsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pp1, e.results.err
+			return e.results.ppa1, e.results.err
 		}
 	}
 
-	if mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.paramPtrs
+	if mmPublishDuePosts.PublishDuePostsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.paramPtrs
 
-		mm_got := PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
+		mm_got := PostMutImpMockPublishDuePostsParams{ctx, now}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
-				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
-			}
-
-			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
-				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+				mmPublishDuePosts.t.Errorf("PostMutImpMock.PublishDuePosts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.commentsEnabled != nil && !minimock.Equal(*mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled) {
-				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter commentsEnabled, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originCommentsEnabled, *mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled, minimock.Diff(*mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled))
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmPublishDuePosts.t.Errorf("PostMutImpMock.PublishDuePosts got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmPublishDuePosts.t.Errorf("PostMutImpMock.PublishDuePosts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.results
+		mm_results := mmPublishDuePosts.PublishDuePostsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateEnableCommentToPost.t.Fatal("No results are set for the PostMutImpMock.UpdateEnableCommentToPost")
+			mmPublishDuePosts.t.Fatal("No results are set for the PostMutImpMock.PublishDuePosts")
 		}
-		return (*mm_results).pp1, (*mm_results).err
+		return (*mm_results).ppa1, (*mm_results).err
 	}
-	if mmUpdateEnableCommentToPost.funcUpdateEnableCommentToPost != nil {
-		return mmUpdateEnableCommentToPost.funcUpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	if mmPublishDuePosts.funcPublishDuePosts != nil {
+		return mmPublishDuePosts.funcPublishDuePosts(ctx, now)
 	}
-	mmUpdateEnableCommentToPost.t.Fatalf("Unexpected call to PostMutImpMock.UpdateEnableCommentToPost. %v %v %v %v", ctx, postID, authorID, commentsEnabled)
+	mmPublishDuePosts.t.Fatalf("Unexpected call to PostMutImpMock.PublishDuePosts. %v %v", ctx, now)
 	return
 }
 
-// UpdateEnableCommentToPostAfterCounter returns a count of finished PostMutImpMock.UpdateEnableCommentToPost invocations
-func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPostAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.afterUpdateEnableCommentToPostCounter)
+// PublishDuePostsAfterCounter returns a count of finished PostMutImpMock.PublishDuePosts invocations
+func (mmPublishDuePosts *PostMutImpMock) PublishDuePostsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishDuePosts.afterPublishDuePostsCounter)
 }
 
-// UpdateEnableCommentToPostBeforeCounter returns a count of PostMutImpMock.UpdateEnableCommentToPost invocations
-func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPostBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.beforeUpdateEnableCommentToPostCounter)
+// PublishDuePostsBeforeCounter returns a count of PostMutImpMock.PublishDuePosts invocations
+func (mmPublishDuePosts *PostMutImpMock) PublishDuePostsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishDuePosts.beforePublishDuePostsCounter)
 }
 
-// Calls returns a list of arguments used in each call to PostMutImpMock.UpdateEnableCommentToPost.
+// Calls returns a list of arguments used in each call to PostMutImpMock.PublishDuePosts.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Calls() []*PostMutImpMockUpdateEnableCommentToPostParams {
-	mmUpdateEnableCommentToPost.mutex.RLock()
+func (mmPublishDuePosts *mPostMutImpMockPublishDuePosts) Calls() []*PostMutImpMockPublishDuePostsParams {
+	mmPublishDuePosts.mutex.RLock()
 
-	argCopy := make([]*PostMutImpMockUpdateEnableCommentToPostParams, len(mmUpdateEnableCommentToPost.callArgs))
-	copy(argCopy, mmUpdateEnableCommentToPost.callArgs)
+	argCopy := make([]*PostMutImpMockPublishDuePostsParams, len(mmPublishDuePosts.callArgs))
+	copy(argCopy, mmPublishDuePosts.callArgs)
 
-	mmUpdateEnableCommentToPost.mutex.RUnlock()
+	mmPublishDuePosts.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateEnableCommentToPostDone returns true if the count of the UpdateEnableCommentToPost invocations corresponds
+// MinimockPublishDuePostsDone returns true if the count of the PublishDuePosts invocations corresponds
 // the number of defined expectations
-func (m *PostMutImpMock) MinimockUpdateEnableCommentToPostDone() bool {
-	if m.UpdateEnableCommentToPostMock.optional {
+func (m *PostMutImpMock) MinimockPublishDuePostsDone() bool {
+	if m.PublishDuePostsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateEnableCommentToPostMock.expectations {
+	for _, e := range m.PublishDuePostsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateEnableCommentToPostMock.invocationsDone()
+	return m.PublishDuePostsMock.invocationsDone()
 }
 
-// MinimockUpdateEnableCommentToPostInspect logs each unmet expectation
-func (m *PostMutImpMock) MinimockUpdateEnableCommentToPostInspect() {
-	for _, e := range m.UpdateEnableCommentToPostMock.expectations {
+// MinimockPublishDuePostsInspect logs each unmet expectation
+func (m *PostMutImpMock) MinimockPublishDuePostsInspect() {
+	for _, e := range m.PublishDuePostsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to PostMutImpMock.PublishDuePosts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateEnableCommentToPostCounter := mm_atomic.LoadUint64(&m.afterUpdateEnableCommentToPostCounter)
+	afterPublishDuePostsCounter := mm_atomic.LoadUint64(&m.afterPublishDuePostsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateEnableCommentToPostMock.defaultExpectation != nil && afterUpdateEnableCommentToPostCounter < 1 {
-		if m.UpdateEnableCommentToPostMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s", m.UpdateEnableCommentToPostMock.defaultExpectation.returnOrigin)
+	if m.PublishDuePostsMock.defaultExpectation != nil && afterPublishDuePostsCounter < 1 {
+		if m.PublishDuePostsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostMutImpMock.PublishDuePosts at\n%s", m.PublishDuePostsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s with params: %#v", m.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEnableCommentToPostMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to PostMutImpMock.PublishDuePosts at\n%s with params: %#v", m.PublishDuePostsMock.defaultExpectation.expectationOrigins.origin, *m.PublishDuePostsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateEnableCommentToPost != nil && afterUpdateEnableCommentToPostCounter < 1 {
-		m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s", m.funcUpdateEnableCommentToPostOrigin)
+	if m.funcPublishDuePosts != nil && afterPublishDuePostsCounter < 1 {
+		m.t.Errorf("Expected call to PostMutImpMock.PublishDuePosts at\n%s", m.funcPublishDuePostsOrigin)
 	}
 
-	if !m.UpdateEnableCommentToPostMock.invocationsDone() && afterUpdateEnableCommentToPostCounter > 0 {
-		m.t.Errorf("Expected %d calls to PostMutImpMock.UpdateEnableCommentToPost at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateEnableCommentToPostMock.expectedInvocations), m.UpdateEnableCommentToPostMock.expectedInvocationsOrigin, afterUpdateEnableCommentToPostCounter)
+	if !m.PublishDuePostsMock.invocationsDone() && afterPublishDuePostsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostMutImpMock.PublishDuePosts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishDuePostsMock.expectedInvocations), m.PublishDuePostsMock.expectedInvocationsOrigin, afterPublishDuePostsCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *PostMutImpMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockAddPostInspect()
+type mPostMutImpMockPublishPost struct {
+	optional           bool
+	mock               *PostMutImpMock
+	defaultExpectation *PostMutImpMockPublishPostExpectation
+	expectations       []*PostMutImpMockPublishPostExpectation
 
-			m.MinimockUpdateEnableCommentToPostInspect()
+	callArgs []*PostMutImpMockPublishPostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostMutImpMockPublishPostExpectation specifies expectation struct of the PostMutImp.PublishPost
+type PostMutImpMockPublishPostExpectation struct {
+	mock               *PostMutImpMock
+	params             *PostMutImpMockPublishPostParams
+	paramPtrs          *PostMutImpMockPublishPostParamPtrs
+	expectationOrigins PostMutImpMockPublishPostExpectationOrigins
+	results            *PostMutImpMockPublishPostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostMutImpMockPublishPostParams contains parameters of the PostMutImp.PublishPost
+type PostMutImpMockPublishPostParams struct {
+	ctx      context.Context
+	postID   int64
+	authorID uuid.UUID
+}
+
+// PostMutImpMockPublishPostParamPtrs contains pointers to parameters of the PostMutImp.PublishPost
+type PostMutImpMockPublishPostParamPtrs struct {
+	ctx      *context.Context
+	postID   *int64
+	authorID *uuid.UUID
+}
+
+// PostMutImpMockPublishPostResults contains results of the PostMutImp.PublishPost
+type PostMutImpMockPublishPostResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// PostMutImpMockPublishPostOrigins contains origins of expectations of the PostMutImp.PublishPost
+type PostMutImpMockPublishPostExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originPostID   string
+	originAuthorID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishPost *mPostMutImpMockPublishPost) Optional() *mPostMutImpMockPublishPost {
+	mmPublishPost.optional = true
+	return mmPublishPost
+}
+
+// Expect sets up expected params for PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) Expect(ctx context.Context, postID int64, authorID uuid.UUID) *mPostMutImpMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &PostMutImpMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by ExpectParams functions")
+	}
+
+	mmPublishPost.defaultExpectation.params = &PostMutImpMockPublishPostParams{ctx, postID, authorID}
+	mmPublishPost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublishPost.expectations {
+		if minimock.Equal(e.params, mmPublishPost.defaultExpectation.params) {
+			mmPublishPost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishPost.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishPost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &PostMutImpMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.params != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Expect")
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs == nil {
+		mmPublishPost.defaultExpectation.paramPtrs = &PostMutImpMockPublishPostParamPtrs{}
+	}
+	mmPublishPost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublishPost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublishPost
+}
+
+// ExpectPostIDParam2 sets up expected param postID for PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) ExpectPostIDParam2(postID int64) *mPostMutImpMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &PostMutImpMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.params != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Expect")
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs == nil {
+		mmPublishPost.defaultExpectation.paramPtrs = &PostMutImpMockPublishPostParamPtrs{}
+	}
+	mmPublishPost.defaultExpectation.paramPtrs.postID = &postID
+	mmPublishPost.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmPublishPost
+}
+
+// ExpectAuthorIDParam3 sets up expected param authorID for PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) ExpectAuthorIDParam3(authorID uuid.UUID) *mPostMutImpMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &PostMutImpMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.params != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Expect")
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs == nil {
+		mmPublishPost.defaultExpectation.paramPtrs = &PostMutImpMockPublishPostParamPtrs{}
+	}
+	mmPublishPost.defaultExpectation.paramPtrs.authorID = &authorID
+	mmPublishPost.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmPublishPost
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) Inspect(f func(ctx context.Context, postID int64, authorID uuid.UUID)) *mPostMutImpMockPublishPost {
+	if mmPublishPost.mock.inspectFuncPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.PublishPost")
+	}
+
+	mmPublishPost.mock.inspectFuncPublishPost = f
+
+	return mmPublishPost
+}
+
+// Return sets up results that will be returned by PostMutImp.PublishPost
+func (mmPublishPost *mPostMutImpMockPublishPost) Return(pp1 *model.Post, err error) *PostMutImpMock {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &PostMutImpMockPublishPostExpectation{mock: mmPublishPost.mock}
+	}
+	mmPublishPost.defaultExpectation.results = &PostMutImpMockPublishPostResults{pp1, err}
+	mmPublishPost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublishPost.mock
+}
+
+// Set uses given function f to mock the PostMutImp.PublishPost method
+func (mmPublishPost *mPostMutImpMockPublishPost) Set(f func(ctx context.Context, postID int64, authorID uuid.UUID) (pp1 *model.Post, err error)) *PostMutImpMock {
+	if mmPublishPost.defaultExpectation != nil {
+		mmPublishPost.mock.t.Fatalf("Default expectation is already set for the PostMutImp.PublishPost method")
+	}
+
+	if len(mmPublishPost.expectations) > 0 {
+		mmPublishPost.mock.t.Fatalf("Some expectations are already set for the PostMutImp.PublishPost method")
+	}
+
+	mmPublishPost.mock.funcPublishPost = f
+	mmPublishPost.mock.funcPublishPostOrigin = minimock.CallerInfo(1)
+	return mmPublishPost.mock
+}
+
+// When sets expectation for the PostMutImp.PublishPost which will trigger the result defined by the following
+// Then helper
+func (mmPublishPost *mPostMutImpMockPublishPost) When(ctx context.Context, postID int64, authorID uuid.UUID) *PostMutImpMockPublishPostExpectation {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("PostMutImpMock.PublishPost mock is already set by Set")
+	}
+
+	expectation := &PostMutImpMockPublishPostExpectation{
+		mock:               mmPublishPost.mock,
+		params:             &PostMutImpMockPublishPostParams{ctx, postID, authorID},
+		expectationOrigins: PostMutImpMockPublishPostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublishPost.expectations = append(mmPublishPost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostMutImp.PublishPost return parameters for the expectation previously defined by the When method
+func (e *PostMutImpMockPublishPostExpectation) Then(pp1 *model.Post, err error) *PostMutImpMock {
+	e.results = &PostMutImpMockPublishPostResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PostMutImp.PublishPost should be invoked
+func (mmPublishPost *mPostMutImpMockPublishPost) Times(n uint64) *mPostMutImpMockPublishPost {
+	if n == 0 {
+		mmPublishPost.mock.t.Fatalf("Times of PostMutImpMock.PublishPost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishPost.expectedInvocations, n)
+	mmPublishPost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublishPost
+}
+
+func (mmPublishPost *mPostMutImpMockPublishPost) invocationsDone() bool {
+	if len(mmPublishPost.expectations) == 0 && mmPublishPost.defaultExpectation == nil && mmPublishPost.mock.funcPublishPost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishPost.mock.afterPublishPostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishPost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishPost implements PostMutImp
+func (mmPublishPost *PostMutImpMock) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmPublishPost.beforePublishPostCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishPost.afterPublishPostCounter, 1)
+
+	mmPublishPost.t.Helper()
+
+	if mmPublishPost.inspectFuncPublishPost != nil {
+		mmPublishPost.inspectFuncPublishPost(ctx, postID, authorID)
+	}
+
+	mm_params := PostMutImpMockPublishPostParams{ctx, postID, authorID}
+
+	// Record call args
+	mmPublishPost.PublishPostMock.mutex.Lock()
+	mmPublishPost.PublishPostMock.callArgs = append(mmPublishPost.PublishPostMock.callArgs, &mm_params)
+	mmPublishPost.PublishPostMock.mutex.Unlock()
+
+	for _, e := range mmPublishPost.PublishPostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmPublishPost.PublishPostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishPost.PublishPostMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishPost.PublishPostMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishPost.PublishPostMock.defaultExpectation.paramPtrs
+
+		mm_got := PostMutImpMockPublishPostParams{ctx, postID, authorID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublishPost.t.Errorf("PostMutImpMock.PublishPost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmPublishPost.t.Errorf("PostMutImpMock.PublishPost got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmPublishPost.t.Errorf("PostMutImpMock.PublishPost got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishPost.t.Errorf("PostMutImpMock.PublishPost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublishPost.PublishPostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublishPost.t.Fatal("No results are set for the PostMutImpMock.PublishPost")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmPublishPost.funcPublishPost != nil {
+		return mmPublishPost.funcPublishPost(ctx, postID, authorID)
+	}
+	mmPublishPost.t.Fatalf("Unexpected call to PostMutImpMock.PublishPost. %v %v %v", ctx, postID, authorID)
+	return
+}
+
+// PublishPostAfterCounter returns a count of finished PostMutImpMock.PublishPost invocations
+func (mmPublishPost *PostMutImpMock) PublishPostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPost.afterPublishPostCounter)
+}
+
+// PublishPostBeforeCounter returns a count of PostMutImpMock.PublishPost invocations
+func (mmPublishPost *PostMutImpMock) PublishPostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPost.beforePublishPostCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostMutImpMock.PublishPost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishPost *mPostMutImpMockPublishPost) Calls() []*PostMutImpMockPublishPostParams {
+	mmPublishPost.mutex.RLock()
+
+	argCopy := make([]*PostMutImpMockPublishPostParams, len(mmPublishPost.callArgs))
+	copy(argCopy, mmPublishPost.callArgs)
+
+	mmPublishPost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishPostDone returns true if the count of the PublishPost invocations corresponds
+// the number of defined expectations
+func (m *PostMutImpMock) MinimockPublishPostDone() bool {
+	if m.PublishPostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishPostMock.invocationsDone()
+}
+
+// MinimockPublishPostInspect logs each unmet expectation
+func (m *PostMutImpMock) MinimockPublishPostInspect() {
+	for _, e := range m.PublishPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostMutImpMock.PublishPost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublishPostCounter := mm_atomic.LoadUint64(&m.afterPublishPostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishPostMock.defaultExpectation != nil && afterPublishPostCounter < 1 {
+		if m.PublishPostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostMutImpMock.PublishPost at\n%s", m.PublishPostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostMutImpMock.PublishPost at\n%s with params: %#v", m.PublishPostMock.defaultExpectation.expectationOrigins.origin, *m.PublishPostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishPost != nil && afterPublishPostCounter < 1 {
+		m.t.Errorf("Expected call to PostMutImpMock.PublishPost at\n%s", m.funcPublishPostOrigin)
+	}
+
+	if !m.PublishPostMock.invocationsDone() && afterPublishPostCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostMutImpMock.PublishPost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishPostMock.expectedInvocations), m.PublishPostMock.expectedInvocationsOrigin, afterPublishPostCounter)
+	}
+}
+
+type mPostMutImpMockSchedulePost struct {
+	optional           bool
+	mock               *PostMutImpMock
+	defaultExpectation *PostMutImpMockSchedulePostExpectation
+	expectations       []*PostMutImpMockSchedulePostExpectation
+
+	callArgs []*PostMutImpMockSchedulePostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostMutImpMockSchedulePostExpectation specifies expectation struct of the PostMutImp.SchedulePost
+type PostMutImpMockSchedulePostExpectation struct {
+	mock               *PostMutImpMock
+	params             *PostMutImpMockSchedulePostParams
+	paramPtrs          *PostMutImpMockSchedulePostParamPtrs
+	expectationOrigins PostMutImpMockSchedulePostExpectationOrigins
+	results            *PostMutImpMockSchedulePostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostMutImpMockSchedulePostParams contains parameters of the PostMutImp.SchedulePost
+type PostMutImpMockSchedulePostParams struct {
+	ctx       context.Context
+	postID    int64
+	authorID  uuid.UUID
+	publishAt time.Time
+}
+
+// PostMutImpMockSchedulePostParamPtrs contains pointers to parameters of the PostMutImp.SchedulePost
+type PostMutImpMockSchedulePostParamPtrs struct {
+	ctx       *context.Context
+	postID    *int64
+	authorID  *uuid.UUID
+	publishAt *time.Time
+}
+
+// PostMutImpMockSchedulePostResults contains results of the PostMutImp.SchedulePost
+type PostMutImpMockSchedulePostResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// PostMutImpMockSchedulePostOrigins contains origins of expectations of the PostMutImp.SchedulePost
+type PostMutImpMockSchedulePostExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originPostID    string
+	originAuthorID  string
+	originPublishAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Optional() *mPostMutImpMockSchedulePost {
+	mmSchedulePost.optional = true
+	return mmSchedulePost
+}
+
+// Expect sets up expected params for PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Expect(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{}
+	}
+
+	if mmSchedulePost.defaultExpectation.paramPtrs != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by ExpectParams functions")
+	}
+
+	mmSchedulePost.defaultExpectation.params = &PostMutImpMockSchedulePostParams{ctx, postID, authorID, publishAt}
+	mmSchedulePost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSchedulePost.expectations {
+		if minimock.Equal(e.params, mmSchedulePost.defaultExpectation.params) {
+			mmSchedulePost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSchedulePost.defaultExpectation.params)
+		}
+	}
+
+	return mmSchedulePost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{}
+	}
+
+	if mmSchedulePost.defaultExpectation.params != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Expect")
+	}
+
+	if mmSchedulePost.defaultExpectation.paramPtrs == nil {
+		mmSchedulePost.defaultExpectation.paramPtrs = &PostMutImpMockSchedulePostParamPtrs{}
+	}
+	mmSchedulePost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSchedulePost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSchedulePost
+}
+
+// ExpectPostIDParam2 sets up expected param postID for PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) ExpectPostIDParam2(postID int64) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{}
+	}
+
+	if mmSchedulePost.defaultExpectation.params != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Expect")
+	}
+
+	if mmSchedulePost.defaultExpectation.paramPtrs == nil {
+		mmSchedulePost.defaultExpectation.paramPtrs = &PostMutImpMockSchedulePostParamPtrs{}
+	}
+	mmSchedulePost.defaultExpectation.paramPtrs.postID = &postID
+	mmSchedulePost.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmSchedulePost
+}
+
+// ExpectAuthorIDParam3 sets up expected param authorID for PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) ExpectAuthorIDParam3(authorID uuid.UUID) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{}
+	}
+
+	if mmSchedulePost.defaultExpectation.params != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Expect")
+	}
+
+	if mmSchedulePost.defaultExpectation.paramPtrs == nil {
+		mmSchedulePost.defaultExpectation.paramPtrs = &PostMutImpMockSchedulePostParamPtrs{}
+	}
+	mmSchedulePost.defaultExpectation.paramPtrs.authorID = &authorID
+	mmSchedulePost.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmSchedulePost
+}
+
+// ExpectPublishAtParam4 sets up expected param publishAt for PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) ExpectPublishAtParam4(publishAt time.Time) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{}
+	}
+
+	if mmSchedulePost.defaultExpectation.params != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Expect")
+	}
+
+	if mmSchedulePost.defaultExpectation.paramPtrs == nil {
+		mmSchedulePost.defaultExpectation.paramPtrs = &PostMutImpMockSchedulePostParamPtrs{}
+	}
+	mmSchedulePost.defaultExpectation.paramPtrs.publishAt = &publishAt
+	mmSchedulePost.defaultExpectation.expectationOrigins.originPublishAt = minimock.CallerInfo(1)
+
+	return mmSchedulePost
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Inspect(f func(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time)) *mPostMutImpMockSchedulePost {
+	if mmSchedulePost.mock.inspectFuncSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.SchedulePost")
+	}
+
+	mmSchedulePost.mock.inspectFuncSchedulePost = f
+
+	return mmSchedulePost
+}
+
+// Return sets up results that will be returned by PostMutImp.SchedulePost
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Return(pp1 *model.Post, err error) *PostMutImpMock {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	if mmSchedulePost.defaultExpectation == nil {
+		mmSchedulePost.defaultExpectation = &PostMutImpMockSchedulePostExpectation{mock: mmSchedulePost.mock}
+	}
+	mmSchedulePost.defaultExpectation.results = &PostMutImpMockSchedulePostResults{pp1, err}
+	mmSchedulePost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSchedulePost.mock
+}
+
+// Set uses given function f to mock the PostMutImp.SchedulePost method
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Set(f func(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (pp1 *model.Post, err error)) *PostMutImpMock {
+	if mmSchedulePost.defaultExpectation != nil {
+		mmSchedulePost.mock.t.Fatalf("Default expectation is already set for the PostMutImp.SchedulePost method")
+	}
+
+	if len(mmSchedulePost.expectations) > 0 {
+		mmSchedulePost.mock.t.Fatalf("Some expectations are already set for the PostMutImp.SchedulePost method")
+	}
+
+	mmSchedulePost.mock.funcSchedulePost = f
+	mmSchedulePost.mock.funcSchedulePostOrigin = minimock.CallerInfo(1)
+	return mmSchedulePost.mock
+}
+
+// When sets expectation for the PostMutImp.SchedulePost which will trigger the result defined by the following
+// Then helper
+func (mmSchedulePost *mPostMutImpMockSchedulePost) When(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) *PostMutImpMockSchedulePostExpectation {
+	if mmSchedulePost.mock.funcSchedulePost != nil {
+		mmSchedulePost.mock.t.Fatalf("PostMutImpMock.SchedulePost mock is already set by Set")
+	}
+
+	expectation := &PostMutImpMockSchedulePostExpectation{
+		mock:               mmSchedulePost.mock,
+		params:             &PostMutImpMockSchedulePostParams{ctx, postID, authorID, publishAt},
+		expectationOrigins: PostMutImpMockSchedulePostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSchedulePost.expectations = append(mmSchedulePost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostMutImp.SchedulePost return parameters for the expectation previously defined by the When method
+func (e *PostMutImpMockSchedulePostExpectation) Then(pp1 *model.Post, err error) *PostMutImpMock {
+	e.results = &PostMutImpMockSchedulePostResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PostMutImp.SchedulePost should be invoked
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Times(n uint64) *mPostMutImpMockSchedulePost {
+	if n == 0 {
+		mmSchedulePost.mock.t.Fatalf("Times of PostMutImpMock.SchedulePost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSchedulePost.expectedInvocations, n)
+	mmSchedulePost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSchedulePost
+}
+
+func (mmSchedulePost *mPostMutImpMockSchedulePost) invocationsDone() bool {
+	if len(mmSchedulePost.expectations) == 0 && mmSchedulePost.defaultExpectation == nil && mmSchedulePost.mock.funcSchedulePost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSchedulePost.mock.afterSchedulePostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSchedulePost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SchedulePost implements PostMutImp
+func (mmSchedulePost *PostMutImpMock) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmSchedulePost.beforeSchedulePostCounter, 1)
+	defer mm_atomic.AddUint64(&mmSchedulePost.afterSchedulePostCounter, 1)
+
+	mmSchedulePost.t.Helper()
+
+	if mmSchedulePost.inspectFuncSchedulePost != nil {
+		mmSchedulePost.inspectFuncSchedulePost(ctx, postID, authorID, publishAt)
+	}
+
+	mm_params := PostMutImpMockSchedulePostParams{ctx, postID, authorID, publishAt}
+
+	// Record call args
+	mmSchedulePost.SchedulePostMock.mutex.Lock()
+	mmSchedulePost.SchedulePostMock.callArgs = append(mmSchedulePost.SchedulePostMock.callArgs, &mm_params)
+	mmSchedulePost.SchedulePostMock.mutex.Unlock()
+
+	for _, e := range mmSchedulePost.SchedulePostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmSchedulePost.SchedulePostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSchedulePost.SchedulePostMock.defaultExpectation.Counter, 1)
+		mm_want := mmSchedulePost.SchedulePostMock.defaultExpectation.params
+		mm_want_ptrs := mmSchedulePost.SchedulePostMock.defaultExpectation.paramPtrs
+
+		mm_got := PostMutImpMockSchedulePostParams{ctx, postID, authorID, publishAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSchedulePost.t.Errorf("PostMutImpMock.SchedulePost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePost.SchedulePostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmSchedulePost.t.Errorf("PostMutImpMock.SchedulePost got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePost.SchedulePostMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmSchedulePost.t.Errorf("PostMutImpMock.SchedulePost got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePost.SchedulePostMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+			if mm_want_ptrs.publishAt != nil && !minimock.Equal(*mm_want_ptrs.publishAt, mm_got.publishAt) {
+				mmSchedulePost.t.Errorf("PostMutImpMock.SchedulePost got unexpected parameter publishAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePost.SchedulePostMock.defaultExpectation.expectationOrigins.originPublishAt, *mm_want_ptrs.publishAt, mm_got.publishAt, minimock.Diff(*mm_want_ptrs.publishAt, mm_got.publishAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSchedulePost.t.Errorf("PostMutImpMock.SchedulePost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSchedulePost.SchedulePostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSchedulePost.SchedulePostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSchedulePost.t.Fatal("No results are set for the PostMutImpMock.SchedulePost")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmSchedulePost.funcSchedulePost != nil {
+		return mmSchedulePost.funcSchedulePost(ctx, postID, authorID, publishAt)
+	}
+	mmSchedulePost.t.Fatalf("Unexpected call to PostMutImpMock.SchedulePost. %v %v %v %v", ctx, postID, authorID, publishAt)
+	return
+}
+
+// SchedulePostAfterCounter returns a count of finished PostMutImpMock.SchedulePost invocations
+func (mmSchedulePost *PostMutImpMock) SchedulePostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePost.afterSchedulePostCounter)
+}
+
+// SchedulePostBeforeCounter returns a count of PostMutImpMock.SchedulePost invocations
+func (mmSchedulePost *PostMutImpMock) SchedulePostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePost.beforeSchedulePostCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostMutImpMock.SchedulePost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSchedulePost *mPostMutImpMockSchedulePost) Calls() []*PostMutImpMockSchedulePostParams {
+	mmSchedulePost.mutex.RLock()
+
+	argCopy := make([]*PostMutImpMockSchedulePostParams, len(mmSchedulePost.callArgs))
+	copy(argCopy, mmSchedulePost.callArgs)
+
+	mmSchedulePost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSchedulePostDone returns true if the count of the SchedulePost invocations corresponds
+// the number of defined expectations
+func (m *PostMutImpMock) MinimockSchedulePostDone() bool {
+	if m.SchedulePostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SchedulePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SchedulePostMock.invocationsDone()
+}
+
+// MinimockSchedulePostInspect logs each unmet expectation
+func (m *PostMutImpMock) MinimockSchedulePostInspect() {
+	for _, e := range m.SchedulePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostMutImpMock.SchedulePost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSchedulePostCounter := mm_atomic.LoadUint64(&m.afterSchedulePostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SchedulePostMock.defaultExpectation != nil && afterSchedulePostCounter < 1 {
+		if m.SchedulePostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostMutImpMock.SchedulePost at\n%s", m.SchedulePostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostMutImpMock.SchedulePost at\n%s with params: %#v", m.SchedulePostMock.defaultExpectation.expectationOrigins.origin, *m.SchedulePostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSchedulePost != nil && afterSchedulePostCounter < 1 {
+		m.t.Errorf("Expected call to PostMutImpMock.SchedulePost at\n%s", m.funcSchedulePostOrigin)
+	}
+
+	if !m.SchedulePostMock.invocationsDone() && afterSchedulePostCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostMutImpMock.SchedulePost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SchedulePostMock.expectedInvocations), m.SchedulePostMock.expectedInvocationsOrigin, afterSchedulePostCounter)
+	}
+}
+
+type mPostMutImpMockUpdateEnableCommentToPost struct {
+	optional           bool
+	mock               *PostMutImpMock
+	defaultExpectation *PostMutImpMockUpdateEnableCommentToPostExpectation
+	expectations       []*PostMutImpMockUpdateEnableCommentToPostExpectation
+
+	callArgs []*PostMutImpMockUpdateEnableCommentToPostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostMutImpMockUpdateEnableCommentToPostExpectation specifies expectation struct of the PostMutImp.UpdateEnableCommentToPost
+type PostMutImpMockUpdateEnableCommentToPostExpectation struct {
+	mock               *PostMutImpMock
+	params             *PostMutImpMockUpdateEnableCommentToPostParams
+	paramPtrs          *PostMutImpMockUpdateEnableCommentToPostParamPtrs
+	expectationOrigins PostMutImpMockUpdateEnableCommentToPostExpectationOrigins
+	results            *PostMutImpMockUpdateEnableCommentToPostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostMutImpMockUpdateEnableCommentToPostParams contains parameters of the PostMutImp.UpdateEnableCommentToPost
+type PostMutImpMockUpdateEnableCommentToPostParams struct {
+	ctx             context.Context
+	postID          int64
+	authorID        uuid.UUID
+	commentsEnabled bool
+}
+
+// PostMutImpMockUpdateEnableCommentToPostParamPtrs contains pointers to parameters of the PostMutImp.UpdateEnableCommentToPost
+type PostMutImpMockUpdateEnableCommentToPostParamPtrs struct {
+	ctx             *context.Context
+	postID          *int64
+	authorID        *uuid.UUID
+	commentsEnabled *bool
+}
+
+// PostMutImpMockUpdateEnableCommentToPostResults contains results of the PostMutImp.UpdateEnableCommentToPost
+type PostMutImpMockUpdateEnableCommentToPostResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// PostMutImpMockUpdateEnableCommentToPostOrigins contains origins of expectations of the PostMutImp.UpdateEnableCommentToPost
+type PostMutImpMockUpdateEnableCommentToPostExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPostID          string
+	originAuthorID        string
+	originCommentsEnabled string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Optional() *mPostMutImpMockUpdateEnableCommentToPost {
+	mmUpdateEnableCommentToPost.optional = true
+	return mmUpdateEnableCommentToPost
+}
+
+// Expect sets up expected params for PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Expect(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateEnableCommentToPost.defaultExpectation.params = &PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
+	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateEnableCommentToPost.expectations {
+		if minimock.Equal(e.params, mmUpdateEnableCommentToPost.defaultExpectation.params) {
+			mmUpdateEnableCommentToPost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateEnableCommentToPost.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateEnableCommentToPost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	}
+	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateEnableCommentToPost
+}
+
+// ExpectPostIDParam2 sets up expected param postID for PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectPostIDParam2(postID int64) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	}
+	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.postID = &postID
+	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmUpdateEnableCommentToPost
+}
+
+// ExpectAuthorIDParam3 sets up expected param authorID for PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectAuthorIDParam3(authorID uuid.UUID) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	}
+	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.authorID = &authorID
+	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmUpdateEnableCommentToPost
+}
+
+// ExpectCommentsEnabledParam4 sets up expected param commentsEnabled for PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) ExpectCommentsEnabledParam4(commentsEnabled bool) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{}
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.params != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Expect")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs = &PostMutImpMockUpdateEnableCommentToPostParamPtrs{}
+	}
+	mmUpdateEnableCommentToPost.defaultExpectation.paramPtrs.commentsEnabled = &commentsEnabled
+	mmUpdateEnableCommentToPost.defaultExpectation.expectationOrigins.originCommentsEnabled = minimock.CallerInfo(1)
+
+	return mmUpdateEnableCommentToPost
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Inspect(f func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool)) *mPostMutImpMockUpdateEnableCommentToPost {
+	if mmUpdateEnableCommentToPost.mock.inspectFuncUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.UpdateEnableCommentToPost")
+	}
+
+	mmUpdateEnableCommentToPost.mock.inspectFuncUpdateEnableCommentToPost = f
+
+	return mmUpdateEnableCommentToPost
+}
+
+// Return sets up results that will be returned by PostMutImp.UpdateEnableCommentToPost
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Return(pp1 *model.Post, err error) *PostMutImpMock {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	if mmUpdateEnableCommentToPost.defaultExpectation == nil {
+		mmUpdateEnableCommentToPost.defaultExpectation = &PostMutImpMockUpdateEnableCommentToPostExpectation{mock: mmUpdateEnableCommentToPost.mock}
+	}
+	mmUpdateEnableCommentToPost.defaultExpectation.results = &PostMutImpMockUpdateEnableCommentToPostResults{pp1, err}
+	mmUpdateEnableCommentToPost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateEnableCommentToPost.mock
+}
+
+// Set uses given function f to mock the PostMutImp.UpdateEnableCommentToPost method
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Set(f func(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (pp1 *model.Post, err error)) *PostMutImpMock {
+	if mmUpdateEnableCommentToPost.defaultExpectation != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("Default expectation is already set for the PostMutImp.UpdateEnableCommentToPost method")
+	}
+
+	if len(mmUpdateEnableCommentToPost.expectations) > 0 {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("Some expectations are already set for the PostMutImp.UpdateEnableCommentToPost method")
+	}
+
+	mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost = f
+	mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPostOrigin = minimock.CallerInfo(1)
+	return mmUpdateEnableCommentToPost.mock
+}
+
+// When sets expectation for the PostMutImp.UpdateEnableCommentToPost which will trigger the result defined by the following
+// Then helper
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) When(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) *PostMutImpMockUpdateEnableCommentToPostExpectation {
+	if mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("PostMutImpMock.UpdateEnableCommentToPost mock is already set by Set")
+	}
+
+	expectation := &PostMutImpMockUpdateEnableCommentToPostExpectation{
+		mock:               mmUpdateEnableCommentToPost.mock,
+		params:             &PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled},
+		expectationOrigins: PostMutImpMockUpdateEnableCommentToPostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateEnableCommentToPost.expectations = append(mmUpdateEnableCommentToPost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostMutImp.UpdateEnableCommentToPost return parameters for the expectation previously defined by the When method
+func (e *PostMutImpMockUpdateEnableCommentToPostExpectation) Then(pp1 *model.Post, err error) *PostMutImpMock {
+	e.results = &PostMutImpMockUpdateEnableCommentToPostResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PostMutImp.UpdateEnableCommentToPost should be invoked
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Times(n uint64) *mPostMutImpMockUpdateEnableCommentToPost {
+	if n == 0 {
+		mmUpdateEnableCommentToPost.mock.t.Fatalf("Times of PostMutImpMock.UpdateEnableCommentToPost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateEnableCommentToPost.expectedInvocations, n)
+	mmUpdateEnableCommentToPost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateEnableCommentToPost
+}
+
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) invocationsDone() bool {
+	if len(mmUpdateEnableCommentToPost.expectations) == 0 && mmUpdateEnableCommentToPost.defaultExpectation == nil && mmUpdateEnableCommentToPost.mock.funcUpdateEnableCommentToPost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.mock.afterUpdateEnableCommentToPostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateEnableCommentToPost implements PostMutImp
+func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.beforeUpdateEnableCommentToPostCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.afterUpdateEnableCommentToPostCounter, 1)
+
+	mmUpdateEnableCommentToPost.t.Helper()
+
+	if mmUpdateEnableCommentToPost.inspectFuncUpdateEnableCommentToPost != nil {
+		mmUpdateEnableCommentToPost.inspectFuncUpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	}
+
+	mm_params := PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
+
+	// Record call args
+	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.mutex.Lock()
+	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.callArgs = append(mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.callArgs, &mm_params)
+	mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.mutex.Unlock()
+
+	for _, e := range mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.paramPtrs
+
+		mm_got := PostMutImpMockUpdateEnableCommentToPostParams{ctx, postID, authorID, commentsEnabled}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+			if mm_want_ptrs.commentsEnabled != nil && !minimock.Equal(*mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled) {
+				mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameter commentsEnabled, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.originCommentsEnabled, *mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled, minimock.Diff(*mm_want_ptrs.commentsEnabled, mm_got.commentsEnabled))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateEnableCommentToPost.t.Errorf("PostMutImpMock.UpdateEnableCommentToPost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateEnableCommentToPost.UpdateEnableCommentToPostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateEnableCommentToPost.t.Fatal("No results are set for the PostMutImpMock.UpdateEnableCommentToPost")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmUpdateEnableCommentToPost.funcUpdateEnableCommentToPost != nil {
+		return mmUpdateEnableCommentToPost.funcUpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	}
+	mmUpdateEnableCommentToPost.t.Fatalf("Unexpected call to PostMutImpMock.UpdateEnableCommentToPost. %v %v %v %v", ctx, postID, authorID, commentsEnabled)
+	return
+}
+
+// UpdateEnableCommentToPostAfterCounter returns a count of finished PostMutImpMock.UpdateEnableCommentToPost invocations
+func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.afterUpdateEnableCommentToPostCounter)
+}
+
+// UpdateEnableCommentToPostBeforeCounter returns a count of PostMutImpMock.UpdateEnableCommentToPost invocations
+func (mmUpdateEnableCommentToPost *PostMutImpMock) UpdateEnableCommentToPostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateEnableCommentToPost.beforeUpdateEnableCommentToPostCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostMutImpMock.UpdateEnableCommentToPost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateEnableCommentToPost *mPostMutImpMockUpdateEnableCommentToPost) Calls() []*PostMutImpMockUpdateEnableCommentToPostParams {
+	mmUpdateEnableCommentToPost.mutex.RLock()
+
+	argCopy := make([]*PostMutImpMockUpdateEnableCommentToPostParams, len(mmUpdateEnableCommentToPost.callArgs))
+	copy(argCopy, mmUpdateEnableCommentToPost.callArgs)
+
+	mmUpdateEnableCommentToPost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateEnableCommentToPostDone returns true if the count of the UpdateEnableCommentToPost invocations corresponds
+// the number of defined expectations
+func (m *PostMutImpMock) MinimockUpdateEnableCommentToPostDone() bool {
+	if m.UpdateEnableCommentToPostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateEnableCommentToPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateEnableCommentToPostMock.invocationsDone()
+}
+
+// MinimockUpdateEnableCommentToPostInspect logs each unmet expectation
+func (m *PostMutImpMock) MinimockUpdateEnableCommentToPostInspect() {
+	for _, e := range m.UpdateEnableCommentToPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateEnableCommentToPostCounter := mm_atomic.LoadUint64(&m.afterUpdateEnableCommentToPostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateEnableCommentToPostMock.defaultExpectation != nil && afterUpdateEnableCommentToPostCounter < 1 {
+		if m.UpdateEnableCommentToPostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s", m.UpdateEnableCommentToPostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s with params: %#v", m.UpdateEnableCommentToPostMock.defaultExpectation.expectationOrigins.origin, *m.UpdateEnableCommentToPostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateEnableCommentToPost != nil && afterUpdateEnableCommentToPostCounter < 1 {
+		m.t.Errorf("Expected call to PostMutImpMock.UpdateEnableCommentToPost at\n%s", m.funcUpdateEnableCommentToPostOrigin)
+	}
+
+	if !m.UpdateEnableCommentToPostMock.invocationsDone() && afterUpdateEnableCommentToPostCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostMutImpMock.UpdateEnableCommentToPost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateEnableCommentToPostMock.expectedInvocations), m.UpdateEnableCommentToPostMock.expectedInvocationsOrigin, afterUpdateEnableCommentToPostCounter)
+	}
+}
+
+type mPostMutImpMockVotePost struct {
+	optional           bool
+	mock               *PostMutImpMock
+	defaultExpectation *PostMutImpMockVotePostExpectation
+	expectations       []*PostMutImpMockVotePostExpectation
+
+	callArgs []*PostMutImpMockVotePostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostMutImpMockVotePostExpectation specifies expectation struct of the PostMutImp.VotePost
+type PostMutImpMockVotePostExpectation struct {
+	mock               *PostMutImpMock
+	params             *PostMutImpMockVotePostParams
+	paramPtrs          *PostMutImpMockVotePostParamPtrs
+	expectationOrigins PostMutImpMockVotePostExpectationOrigins
+	results            *PostMutImpMockVotePostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostMutImpMockVotePostParams contains parameters of the PostMutImp.VotePost
+type PostMutImpMockVotePostParams struct {
+	ctx    context.Context
+	postID int64
+	userID uuid.UUID
+	value  model.VoteValue
+}
+
+// PostMutImpMockVotePostParamPtrs contains pointers to parameters of the PostMutImp.VotePost
+type PostMutImpMockVotePostParamPtrs struct {
+	ctx    *context.Context
+	postID *int64
+	userID *uuid.UUID
+	value  *model.VoteValue
+}
+
+// PostMutImpMockVotePostResults contains results of the PostMutImp.VotePost
+type PostMutImpMockVotePostResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// PostMutImpMockVotePostOrigins contains origins of expectations of the PostMutImp.VotePost
+type PostMutImpMockVotePostExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPostID string
+	originUserID string
+	originValue  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVotePost *mPostMutImpMockVotePost) Optional() *mPostMutImpMockVotePost {
+	mmVotePost.optional = true
+	return mmVotePost
+}
+
+// Expect sets up expected params for PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) Expect(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{}
+	}
+
+	if mmVotePost.defaultExpectation.paramPtrs != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by ExpectParams functions")
+	}
+
+	mmVotePost.defaultExpectation.params = &PostMutImpMockVotePostParams{ctx, postID, userID, value}
+	mmVotePost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmVotePost.expectations {
+		if minimock.Equal(e.params, mmVotePost.defaultExpectation.params) {
+			mmVotePost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVotePost.defaultExpectation.params)
+		}
+	}
+
+	return mmVotePost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) ExpectCtxParam1(ctx context.Context) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{}
+	}
+
+	if mmVotePost.defaultExpectation.params != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Expect")
+	}
+
+	if mmVotePost.defaultExpectation.paramPtrs == nil {
+		mmVotePost.defaultExpectation.paramPtrs = &PostMutImpMockVotePostParamPtrs{}
+	}
+	mmVotePost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmVotePost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmVotePost
+}
+
+// ExpectPostIDParam2 sets up expected param postID for PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) ExpectPostIDParam2(postID int64) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{}
+	}
+
+	if mmVotePost.defaultExpectation.params != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Expect")
+	}
+
+	if mmVotePost.defaultExpectation.paramPtrs == nil {
+		mmVotePost.defaultExpectation.paramPtrs = &PostMutImpMockVotePostParamPtrs{}
+	}
+	mmVotePost.defaultExpectation.paramPtrs.postID = &postID
+	mmVotePost.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmVotePost
+}
+
+// ExpectUserIDParam3 sets up expected param userID for PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) ExpectUserIDParam3(userID uuid.UUID) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{}
+	}
+
+	if mmVotePost.defaultExpectation.params != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Expect")
+	}
+
+	if mmVotePost.defaultExpectation.paramPtrs == nil {
+		mmVotePost.defaultExpectation.paramPtrs = &PostMutImpMockVotePostParamPtrs{}
+	}
+	mmVotePost.defaultExpectation.paramPtrs.userID = &userID
+	mmVotePost.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmVotePost
+}
+
+// ExpectValueParam4 sets up expected param value for PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) ExpectValueParam4(value model.VoteValue) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{}
+	}
+
+	if mmVotePost.defaultExpectation.params != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Expect")
+	}
+
+	if mmVotePost.defaultExpectation.paramPtrs == nil {
+		mmVotePost.defaultExpectation.paramPtrs = &PostMutImpMockVotePostParamPtrs{}
+	}
+	mmVotePost.defaultExpectation.paramPtrs.value = &value
+	mmVotePost.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmVotePost
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) Inspect(f func(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue)) *mPostMutImpMockVotePost {
+	if mmVotePost.mock.inspectFuncVotePost != nil {
+		mmVotePost.mock.t.Fatalf("Inspect function is already set for PostMutImpMock.VotePost")
+	}
+
+	mmVotePost.mock.inspectFuncVotePost = f
+
+	return mmVotePost
+}
+
+// Return sets up results that will be returned by PostMutImp.VotePost
+func (mmVotePost *mPostMutImpMockVotePost) Return(pp1 *model.Post, err error) *PostMutImpMock {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	if mmVotePost.defaultExpectation == nil {
+		mmVotePost.defaultExpectation = &PostMutImpMockVotePostExpectation{mock: mmVotePost.mock}
+	}
+	mmVotePost.defaultExpectation.results = &PostMutImpMockVotePostResults{pp1, err}
+	mmVotePost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmVotePost.mock
+}
+
+// Set uses given function f to mock the PostMutImp.VotePost method
+func (mmVotePost *mPostMutImpMockVotePost) Set(f func(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (pp1 *model.Post, err error)) *PostMutImpMock {
+	if mmVotePost.defaultExpectation != nil {
+		mmVotePost.mock.t.Fatalf("Default expectation is already set for the PostMutImp.VotePost method")
+	}
+
+	if len(mmVotePost.expectations) > 0 {
+		mmVotePost.mock.t.Fatalf("Some expectations are already set for the PostMutImp.VotePost method")
+	}
+
+	mmVotePost.mock.funcVotePost = f
+	mmVotePost.mock.funcVotePostOrigin = minimock.CallerInfo(1)
+	return mmVotePost.mock
+}
+
+// When sets expectation for the PostMutImp.VotePost which will trigger the result defined by the following
+// Then helper
+func (mmVotePost *mPostMutImpMockVotePost) When(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) *PostMutImpMockVotePostExpectation {
+	if mmVotePost.mock.funcVotePost != nil {
+		mmVotePost.mock.t.Fatalf("PostMutImpMock.VotePost mock is already set by Set")
+	}
+
+	expectation := &PostMutImpMockVotePostExpectation{
+		mock:               mmVotePost.mock,
+		params:             &PostMutImpMockVotePostParams{ctx, postID, userID, value},
+		expectationOrigins: PostMutImpMockVotePostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmVotePost.expectations = append(mmVotePost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostMutImp.VotePost return parameters for the expectation previously defined by the When method
+func (e *PostMutImpMockVotePostExpectation) Then(pp1 *model.Post, err error) *PostMutImpMock {
+	e.results = &PostMutImpMockVotePostResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times PostMutImp.VotePost should be invoked
+func (mmVotePost *mPostMutImpMockVotePost) Times(n uint64) *mPostMutImpMockVotePost {
+	if n == 0 {
+		mmVotePost.mock.t.Fatalf("Times of PostMutImpMock.VotePost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVotePost.expectedInvocations, n)
+	mmVotePost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmVotePost
+}
+
+func (mmVotePost *mPostMutImpMockVotePost) invocationsDone() bool {
+	if len(mmVotePost.expectations) == 0 && mmVotePost.defaultExpectation == nil && mmVotePost.mock.funcVotePost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVotePost.mock.afterVotePostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVotePost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VotePost implements PostMutImp
+func (mmVotePost *PostMutImpMock) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmVotePost.beforeVotePostCounter, 1)
+	defer mm_atomic.AddUint64(&mmVotePost.afterVotePostCounter, 1)
+
+	mmVotePost.t.Helper()
+
+	if mmVotePost.inspectFuncVotePost != nil {
+		mmVotePost.inspectFuncVotePost(ctx, postID, userID, value)
+	}
+
+	mm_params := PostMutImpMockVotePostParams{ctx, postID, userID, value}
+
+	// Record call args
+	mmVotePost.VotePostMock.mutex.Lock()
+	mmVotePost.VotePostMock.callArgs = append(mmVotePost.VotePostMock.callArgs, &mm_params)
+	mmVotePost.VotePostMock.mutex.Unlock()
+
+	for _, e := range mmVotePost.VotePostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmVotePost.VotePostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVotePost.VotePostMock.defaultExpectation.Counter, 1)
+		mm_want := mmVotePost.VotePostMock.defaultExpectation.params
+		mm_want_ptrs := mmVotePost.VotePostMock.defaultExpectation.paramPtrs
+
+		mm_got := PostMutImpMockVotePostParams{ctx, postID, userID, value}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVotePost.t.Errorf("PostMutImpMock.VotePost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVotePost.VotePostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmVotePost.t.Errorf("PostMutImpMock.VotePost got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVotePost.VotePostMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmVotePost.t.Errorf("PostMutImpMock.VotePost got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVotePost.VotePostMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmVotePost.t.Errorf("PostMutImpMock.VotePost got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmVotePost.VotePostMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVotePost.t.Errorf("PostMutImpMock.VotePost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmVotePost.VotePostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVotePost.VotePostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVotePost.t.Fatal("No results are set for the PostMutImpMock.VotePost")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmVotePost.funcVotePost != nil {
+		return mmVotePost.funcVotePost(ctx, postID, userID, value)
+	}
+	mmVotePost.t.Fatalf("Unexpected call to PostMutImpMock.VotePost. %v %v %v %v", ctx, postID, userID, value)
+	return
+}
+
+// VotePostAfterCounter returns a count of finished PostMutImpMock.VotePost invocations
+func (mmVotePost *PostMutImpMock) VotePostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVotePost.afterVotePostCounter)
+}
+
+// VotePostBeforeCounter returns a count of PostMutImpMock.VotePost invocations
+func (mmVotePost *PostMutImpMock) VotePostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVotePost.beforeVotePostCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostMutImpMock.VotePost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVotePost *mPostMutImpMockVotePost) Calls() []*PostMutImpMockVotePostParams {
+	mmVotePost.mutex.RLock()
+
+	argCopy := make([]*PostMutImpMockVotePostParams, len(mmVotePost.callArgs))
+	copy(argCopy, mmVotePost.callArgs)
+
+	mmVotePost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVotePostDone returns true if the count of the VotePost invocations corresponds
+// the number of defined expectations
+func (m *PostMutImpMock) MinimockVotePostDone() bool {
+	if m.VotePostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VotePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VotePostMock.invocationsDone()
+}
+
+// MinimockVotePostInspect logs each unmet expectation
+func (m *PostMutImpMock) MinimockVotePostInspect() {
+	for _, e := range m.VotePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostMutImpMock.VotePost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterVotePostCounter := mm_atomic.LoadUint64(&m.afterVotePostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VotePostMock.defaultExpectation != nil && afterVotePostCounter < 1 {
+		if m.VotePostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostMutImpMock.VotePost at\n%s", m.VotePostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostMutImpMock.VotePost at\n%s with params: %#v", m.VotePostMock.defaultExpectation.expectationOrigins.origin, *m.VotePostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVotePost != nil && afterVotePostCounter < 1 {
+		m.t.Errorf("Expected call to PostMutImpMock.VotePost at\n%s", m.funcVotePostOrigin)
+	}
+
+	if !m.VotePostMock.invocationsDone() && afterVotePostCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostMutImpMock.VotePost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.VotePostMock.expectedInvocations), m.VotePostMock.expectedInvocationsOrigin, afterVotePostCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PostMutImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddPostInspect()
+
+			m.MinimockPublishDuePostsInspect()
+
+			m.MinimockPublishPostInspect()
+
+			m.MinimockSchedulePostInspect()
+
+			m.MinimockUpdateEnableCommentToPostInspect()
+
+			m.MinimockVotePostInspect()
 		}
 	})
 }
@@ -833,5 +2409,9 @@ func (m *PostMutImpMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddPostDone() &&
-		m.MinimockUpdateEnableCommentToPostDone()
+		m.MinimockPublishDuePostsDone() &&
+		m.MinimockPublishPostDone() &&
+		m.MinimockSchedulePostDone() &&
+		m.MinimockUpdateEnableCommentToPostDone() &&
+		m.MinimockVotePostDone()
 }
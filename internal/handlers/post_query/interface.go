@@ -3,11 +3,15 @@ package postquery
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
 //go:generate minimock -i PostQueryImp
 type PostQueryImp interface {
-	GetAllPosts(ctx context.Context) ([]*model.Post, error)
+	GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error)
 	GetPost(ctx context.Context, postID int64) (*model.Post, error)
+	GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error)
+	GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error)
+	GetTags(ctx context.Context) ([]model.Tag, error)
 }
@@ -0,0 +1,79 @@
+package postquery
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/dataloader"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+)
+
+const (
+	loaderWait     = time.Millisecond
+	loaderMaxBatch = 100
+)
+
+type loadersKey struct{}
+
+type loaders struct {
+	votes *dataloader.Loader[int64, model.VoteValue]
+	tags  *dataloader.Loader[int64, []string]
+}
+
+// WithLoaders returns the context with the loaders batching the post lookups
+// of one request.
+func (h *PostQuery) WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		votes: dataloader.New(h.loadVotes, loaderWait, loaderMaxBatch),
+		tags:  dataloader.New(h.loadTags, loaderWait, loaderMaxBatch),
+	})
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
+
+// loadVotes loads the viewer's votes, the viewer is the same for the whole
+// request, so it is taken from the context of the batch.
+func (h *PostQuery) loadVotes(ctx context.Context, postIDs []int64) ([]model.VoteValue, []error) {
+	values := make([]model.VoteValue, len(postIDs))
+
+	userID, ok := viewer.FromContext(ctx)
+	if !ok {
+		return values, nil
+	}
+
+	votes, err := h.postQueryImp.GetPostVotes(ctx, userID, postIDs)
+	if err != nil {
+		loadErrs := make([]error, len(postIDs))
+		for i := range loadErrs {
+			loadErrs[i] = err
+		}
+		return values, loadErrs
+	}
+
+	for i, id := range postIDs {
+		values[i] = votes[id]
+	}
+	return values, nil
+}
+
+func (h *PostQuery) loadTags(ctx context.Context, postIDs []int64) ([][]string, []error) {
+	values := make([][]string, len(postIDs))
+
+	tags, err := h.postQueryImp.GetPostTags(ctx, postIDs)
+	if err != nil {
+		loadErrs := make([]error, len(postIDs))
+		for i := range loadErrs {
+			loadErrs[i] = err
+		}
+		return values, loadErrs
+	}
+
+	for i, id := range postIDs {
+		values[i] = tags[id]
+	}
+	return values, nil
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
 
@@ -19,9 +20,9 @@ type PostQueryImpMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcGetAllPosts          func(ctx context.Context) (ppa1 []*model.Post, err error)
+	funcGetAllPosts          func(ctx context.Context, opts model.PostsOptions) (ppa1 []*model.Post, err error)
 	funcGetAllPostsOrigin    string
-	inspectFuncGetAllPosts   func(ctx context.Context)
+	inspectFuncGetAllPosts   func(ctx context.Context, opts model.PostsOptions)
 	afterGetAllPostsCounter  uint64
 	beforeGetAllPostsCounter uint64
 	GetAllPostsMock          mPostQueryImpMockGetAllPosts
@@ -32,6 +33,27 @@ type PostQueryImpMock struct {
 	afterGetPostCounter  uint64
 	beforeGetPostCounter uint64
 	GetPostMock          mPostQueryImpMockGetPost
+
+	funcGetPostTags          func(ctx context.Context, postIDs []int64) (m1 map[int64][]string, err error)
+	funcGetPostTagsOrigin    string
+	inspectFuncGetPostTags   func(ctx context.Context, postIDs []int64)
+	afterGetPostTagsCounter  uint64
+	beforeGetPostTagsCounter uint64
+	GetPostTagsMock          mPostQueryImpMockGetPostTags
+
+	funcGetPostVotes          func(ctx context.Context, userID uuid.UUID, postIDs []int64) (m1 map[int64]model.VoteValue, err error)
+	funcGetPostVotesOrigin    string
+	inspectFuncGetPostVotes   func(ctx context.Context, userID uuid.UUID, postIDs []int64)
+	afterGetPostVotesCounter  uint64
+	beforeGetPostVotesCounter uint64
+	GetPostVotesMock          mPostQueryImpMockGetPostVotes
+
+	funcGetTags          func(ctx context.Context) (ta1 []model.Tag, err error)
+	funcGetTagsOrigin    string
+	inspectFuncGetTags   func(ctx context.Context)
+	afterGetTagsCounter  uint64
+	beforeGetTagsCounter uint64
+	GetTagsMock          mPostQueryImpMockGetTags
 }
 
 // NewPostQueryImpMock returns a mock for PostQueryImp
@@ -48,6 +70,15 @@ func NewPostQueryImpMock(t minimock.Tester) *PostQueryImpMock {
 	m.GetPostMock = mPostQueryImpMockGetPost{mock: m}
 	m.GetPostMock.callArgs = []*PostQueryImpMockGetPostParams{}
 
+	m.GetPostTagsMock = mPostQueryImpMockGetPostTags{mock: m}
+	m.GetPostTagsMock.callArgs = []*PostQueryImpMockGetPostTagsParams{}
+
+	m.GetPostVotesMock = mPostQueryImpMockGetPostVotes{mock: m}
+	m.GetPostVotesMock.callArgs = []*PostQueryImpMockGetPostVotesParams{}
+
+	m.GetTagsMock = mPostQueryImpMockGetTags{mock: m}
+	m.GetTagsMock.callArgs = []*PostQueryImpMockGetTagsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -79,12 +110,14 @@ type PostQueryImpMockGetAllPostsExpectation struct {
 
 // PostQueryImpMockGetAllPostsParams contains parameters of the PostQueryImp.GetAllPosts
 type PostQueryImpMockGetAllPostsParams struct {
-	ctx context.Context
+	ctx  context.Context
+	opts model.PostsOptions
 }
 
 // PostQueryImpMockGetAllPostsParamPtrs contains pointers to parameters of the PostQueryImp.GetAllPosts
 type PostQueryImpMockGetAllPostsParamPtrs struct {
-	ctx *context.Context
+	ctx  *context.Context
+	opts *model.PostsOptions
 }
 
 // PostQueryImpMockGetAllPostsResults contains results of the PostQueryImp.GetAllPosts
@@ -95,8 +128,9 @@ type PostQueryImpMockGetAllPostsResults struct {
 
 // PostQueryImpMockGetAllPostsOrigins contains origins of expectations of the PostQueryImp.GetAllPosts
 type PostQueryImpMockGetAllPostsExpectationOrigins struct {
-	origin    string
-	originCtx string
+	origin     string
+	originCtx  string
+	originOpts string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -110,7 +144,7 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Optional() *mPostQueryImpMock
 }
 
 // Expect sets up expected params for PostQueryImp.GetAllPosts
-func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Expect(ctx context.Context) *mPostQueryImpMockGetAllPosts {
+func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Expect(ctx context.Context, opts model.PostsOptions) *mPostQueryImpMockGetAllPosts {
 	if mmGetAllPosts.mock.funcGetAllPosts != nil {
 		mmGetAllPosts.mock.t.Fatalf("PostQueryImpMock.GetAllPosts mock is already set by Set")
 	}
@@ -123,7 +157,7 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Expect(ctx context.Context) *
 		mmGetAllPosts.mock.t.Fatalf("PostQueryImpMock.GetAllPosts mock is already set by ExpectParams functions")
 	}
 
-	mmGetAllPosts.defaultExpectation.params = &PostQueryImpMockGetAllPostsParams{ctx}
+	mmGetAllPosts.defaultExpectation.params = &PostQueryImpMockGetAllPostsParams{ctx, opts}
 	mmGetAllPosts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetAllPosts.expectations {
 		if minimock.Equal(e.params, mmGetAllPosts.defaultExpectation.params) {
@@ -157,8 +191,31 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) ExpectCtxParam1(ctx context.C
 	return mmGetAllPosts
 }
 
+// ExpectOptsParam2 sets up expected param opts for PostQueryImp.GetAllPosts
+func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) ExpectOptsParam2(opts model.PostsOptions) *mPostQueryImpMockGetAllPosts {
+	if mmGetAllPosts.mock.funcGetAllPosts != nil {
+		mmGetAllPosts.mock.t.Fatalf("PostQueryImpMock.GetAllPosts mock is already set by Set")
+	}
+
+	if mmGetAllPosts.defaultExpectation == nil {
+		mmGetAllPosts.defaultExpectation = &PostQueryImpMockGetAllPostsExpectation{}
+	}
+
+	if mmGetAllPosts.defaultExpectation.params != nil {
+		mmGetAllPosts.mock.t.Fatalf("PostQueryImpMock.GetAllPosts mock is already set by Expect")
+	}
+
+	if mmGetAllPosts.defaultExpectation.paramPtrs == nil {
+		mmGetAllPosts.defaultExpectation.paramPtrs = &PostQueryImpMockGetAllPostsParamPtrs{}
+	}
+	mmGetAllPosts.defaultExpectation.paramPtrs.opts = &opts
+	mmGetAllPosts.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmGetAllPosts
+}
+
 // Inspect accepts an inspector function that has same arguments as the PostQueryImp.GetAllPosts
-func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Inspect(f func(ctx context.Context)) *mPostQueryImpMockGetAllPosts {
+func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Inspect(f func(ctx context.Context, opts model.PostsOptions)) *mPostQueryImpMockGetAllPosts {
 	if mmGetAllPosts.mock.inspectFuncGetAllPosts != nil {
 		mmGetAllPosts.mock.t.Fatalf("Inspect function is already set for PostQueryImpMock.GetAllPosts")
 	}
@@ -183,7 +240,7 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Return(ppa1 []*model.Post, er
 }
 
 // Set uses given function f to mock the PostQueryImp.GetAllPosts method
-func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Set(f func(ctx context.Context) (ppa1 []*model.Post, err error)) *PostQueryImpMock {
+func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Set(f func(ctx context.Context, opts model.PostsOptions) (ppa1 []*model.Post, err error)) *PostQueryImpMock {
 	if mmGetAllPosts.defaultExpectation != nil {
 		mmGetAllPosts.mock.t.Fatalf("Default expectation is already set for the PostQueryImp.GetAllPosts method")
 	}
@@ -199,14 +256,14 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) Set(f func(ctx context.Contex
 
 // When sets expectation for the PostQueryImp.GetAllPosts which will trigger the result defined by the following
 // Then helper
-func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) When(ctx context.Context) *PostQueryImpMockGetAllPostsExpectation {
+func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) When(ctx context.Context, opts model.PostsOptions) *PostQueryImpMockGetAllPostsExpectation {
 	if mmGetAllPosts.mock.funcGetAllPosts != nil {
 		mmGetAllPosts.mock.t.Fatalf("PostQueryImpMock.GetAllPosts mock is already set by Set")
 	}
 
 	expectation := &PostQueryImpMockGetAllPostsExpectation{
 		mock:               mmGetAllPosts.mock,
-		params:             &PostQueryImpMockGetAllPostsParams{ctx},
+		params:             &PostQueryImpMockGetAllPostsParams{ctx, opts},
 		expectationOrigins: PostQueryImpMockGetAllPostsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetAllPosts.expectations = append(mmGetAllPosts.expectations, expectation)
@@ -241,17 +298,17 @@ func (mmGetAllPosts *mPostQueryImpMockGetAllPosts) invocationsDone() bool {
 }
 
 // GetAllPosts implements PostQueryImp
-func (mmGetAllPosts *PostQueryImpMock) GetAllPosts(ctx context.Context) (ppa1 []*model.Post, err error) {
+func (mmGetAllPosts *PostQueryImpMock) GetAllPosts(ctx context.Context, opts model.PostsOptions) (ppa1 []*model.Post, err error) {
 	mm_atomic.AddUint64(&mmGetAllPosts.beforeGetAllPostsCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetAllPosts.afterGetAllPostsCounter, 1)
 
 	mmGetAllPosts.t.Helper()
 
 	if mmGetAllPosts.inspectFuncGetAllPosts != nil {
-		mmGetAllPosts.inspectFuncGetAllPosts(ctx)
+		mmGetAllPosts.inspectFuncGetAllPosts(ctx, opts)
 	}
 
-	mm_params := PostQueryImpMockGetAllPostsParams{ctx}
+	mm_params := PostQueryImpMockGetAllPostsParams{ctx, opts}
 
 	// Record call args
 	mmGetAllPosts.GetAllPostsMock.mutex.Lock()
@@ -270,7 +327,7 @@ func (mmGetAllPosts *PostQueryImpMock) GetAllPosts(ctx context.Context) (ppa1 []
 		mm_want := mmGetAllPosts.GetAllPostsMock.defaultExpectation.params
 		mm_want_ptrs := mmGetAllPosts.GetAllPostsMock.defaultExpectation.paramPtrs
 
-		mm_got := PostQueryImpMockGetAllPostsParams{ctx}
+		mm_got := PostQueryImpMockGetAllPostsParams{ctx, opts}
 
 		if mm_want_ptrs != nil {
 
@@ -279,6 +336,11 @@ func (mmGetAllPosts *PostQueryImpMock) GetAllPosts(ctx context.Context) (ppa1 []
 					mmGetAllPosts.GetAllPostsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmGetAllPosts.t.Errorf("PostQueryImpMock.GetAllPosts got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAllPosts.GetAllPostsMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetAllPosts.t.Errorf("PostQueryImpMock.GetAllPosts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmGetAllPosts.GetAllPostsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -291,9 +353,9 @@ func (mmGetAllPosts *PostQueryImpMock) GetAllPosts(ctx context.Context) (ppa1 []
 		return (*mm_results).ppa1, (*mm_results).err
 	}
 	if mmGetAllPosts.funcGetAllPosts != nil {
-		return mmGetAllPosts.funcGetAllPosts(ctx)
+		return mmGetAllPosts.funcGetAllPosts(ctx, opts)
 	}
-	mmGetAllPosts.t.Fatalf("Unexpected call to PostQueryImpMock.GetAllPosts. %v", ctx)
+	mmGetAllPosts.t.Fatalf("Unexpected call to PostQueryImpMock.GetAllPosts. %v %v", ctx, opts)
 	return
 }
 
@@ -708,13 +770,1048 @@ func (m *PostQueryImpMock) MinimockGetPostInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *PostQueryImpMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetAllPostsInspect()
+type mPostQueryImpMockGetPostTags struct {
+	optional           bool
+	mock               *PostQueryImpMock
+	defaultExpectation *PostQueryImpMockGetPostTagsExpectation
+	expectations       []*PostQueryImpMockGetPostTagsExpectation
 
-			m.MinimockGetPostInspect()
+	callArgs []*PostQueryImpMockGetPostTagsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostQueryImpMockGetPostTagsExpectation specifies expectation struct of the PostQueryImp.GetPostTags
+type PostQueryImpMockGetPostTagsExpectation struct {
+	mock               *PostQueryImpMock
+	params             *PostQueryImpMockGetPostTagsParams
+	paramPtrs          *PostQueryImpMockGetPostTagsParamPtrs
+	expectationOrigins PostQueryImpMockGetPostTagsExpectationOrigins
+	results            *PostQueryImpMockGetPostTagsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostQueryImpMockGetPostTagsParams contains parameters of the PostQueryImp.GetPostTags
+type PostQueryImpMockGetPostTagsParams struct {
+	ctx     context.Context
+	postIDs []int64
+}
+
+// PostQueryImpMockGetPostTagsParamPtrs contains pointers to parameters of the PostQueryImp.GetPostTags
+type PostQueryImpMockGetPostTagsParamPtrs struct {
+	ctx     *context.Context
+	postIDs *[]int64
+}
+
+// PostQueryImpMockGetPostTagsResults contains results of the PostQueryImp.GetPostTags
+type PostQueryImpMockGetPostTagsResults struct {
+	m1  map[int64][]string
+	err error
+}
+
+// PostQueryImpMockGetPostTagsOrigins contains origins of expectations of the PostQueryImp.GetPostTags
+type PostQueryImpMockGetPostTagsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originPostIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Optional() *mPostQueryImpMockGetPostTags {
+	mmGetPostTags.optional = true
+	return mmGetPostTags
+}
+
+// Expect sets up expected params for PostQueryImp.GetPostTags
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Expect(ctx context.Context, postIDs []int64) *mPostQueryImpMockGetPostTags {
+	if mmGetPostTags.mock.funcGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Set")
+	}
+
+	if mmGetPostTags.defaultExpectation == nil {
+		mmGetPostTags.defaultExpectation = &PostQueryImpMockGetPostTagsExpectation{}
+	}
+
+	if mmGetPostTags.defaultExpectation.paramPtrs != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by ExpectParams functions")
+	}
+
+	mmGetPostTags.defaultExpectation.params = &PostQueryImpMockGetPostTagsParams{ctx, postIDs}
+	mmGetPostTags.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPostTags.expectations {
+		if minimock.Equal(e.params, mmGetPostTags.defaultExpectation.params) {
+			mmGetPostTags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPostTags.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPostTags
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostQueryImp.GetPostTags
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) ExpectCtxParam1(ctx context.Context) *mPostQueryImpMockGetPostTags {
+	if mmGetPostTags.mock.funcGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Set")
+	}
+
+	if mmGetPostTags.defaultExpectation == nil {
+		mmGetPostTags.defaultExpectation = &PostQueryImpMockGetPostTagsExpectation{}
+	}
+
+	if mmGetPostTags.defaultExpectation.params != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Expect")
+	}
+
+	if mmGetPostTags.defaultExpectation.paramPtrs == nil {
+		mmGetPostTags.defaultExpectation.paramPtrs = &PostQueryImpMockGetPostTagsParamPtrs{}
+	}
+	mmGetPostTags.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPostTags.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPostTags
+}
+
+// ExpectPostIDsParam2 sets up expected param postIDs for PostQueryImp.GetPostTags
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) ExpectPostIDsParam2(postIDs []int64) *mPostQueryImpMockGetPostTags {
+	if mmGetPostTags.mock.funcGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Set")
+	}
+
+	if mmGetPostTags.defaultExpectation == nil {
+		mmGetPostTags.defaultExpectation = &PostQueryImpMockGetPostTagsExpectation{}
+	}
+
+	if mmGetPostTags.defaultExpectation.params != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Expect")
+	}
+
+	if mmGetPostTags.defaultExpectation.paramPtrs == nil {
+		mmGetPostTags.defaultExpectation.paramPtrs = &PostQueryImpMockGetPostTagsParamPtrs{}
+	}
+	mmGetPostTags.defaultExpectation.paramPtrs.postIDs = &postIDs
+	mmGetPostTags.defaultExpectation.expectationOrigins.originPostIDs = minimock.CallerInfo(1)
+
+	return mmGetPostTags
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostQueryImp.GetPostTags
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Inspect(f func(ctx context.Context, postIDs []int64)) *mPostQueryImpMockGetPostTags {
+	if mmGetPostTags.mock.inspectFuncGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("Inspect function is already set for PostQueryImpMock.GetPostTags")
+	}
+
+	mmGetPostTags.mock.inspectFuncGetPostTags = f
+
+	return mmGetPostTags
+}
+
+// Return sets up results that will be returned by PostQueryImp.GetPostTags
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Return(m1 map[int64][]string, err error) *PostQueryImpMock {
+	if mmGetPostTags.mock.funcGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Set")
+	}
+
+	if mmGetPostTags.defaultExpectation == nil {
+		mmGetPostTags.defaultExpectation = &PostQueryImpMockGetPostTagsExpectation{mock: mmGetPostTags.mock}
+	}
+	mmGetPostTags.defaultExpectation.results = &PostQueryImpMockGetPostTagsResults{m1, err}
+	mmGetPostTags.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPostTags.mock
+}
+
+// Set uses given function f to mock the PostQueryImp.GetPostTags method
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Set(f func(ctx context.Context, postIDs []int64) (m1 map[int64][]string, err error)) *PostQueryImpMock {
+	if mmGetPostTags.defaultExpectation != nil {
+		mmGetPostTags.mock.t.Fatalf("Default expectation is already set for the PostQueryImp.GetPostTags method")
+	}
+
+	if len(mmGetPostTags.expectations) > 0 {
+		mmGetPostTags.mock.t.Fatalf("Some expectations are already set for the PostQueryImp.GetPostTags method")
+	}
+
+	mmGetPostTags.mock.funcGetPostTags = f
+	mmGetPostTags.mock.funcGetPostTagsOrigin = minimock.CallerInfo(1)
+	return mmGetPostTags.mock
+}
+
+// When sets expectation for the PostQueryImp.GetPostTags which will trigger the result defined by the following
+// Then helper
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) When(ctx context.Context, postIDs []int64) *PostQueryImpMockGetPostTagsExpectation {
+	if mmGetPostTags.mock.funcGetPostTags != nil {
+		mmGetPostTags.mock.t.Fatalf("PostQueryImpMock.GetPostTags mock is already set by Set")
+	}
+
+	expectation := &PostQueryImpMockGetPostTagsExpectation{
+		mock:               mmGetPostTags.mock,
+		params:             &PostQueryImpMockGetPostTagsParams{ctx, postIDs},
+		expectationOrigins: PostQueryImpMockGetPostTagsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPostTags.expectations = append(mmGetPostTags.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostQueryImp.GetPostTags return parameters for the expectation previously defined by the When method
+func (e *PostQueryImpMockGetPostTagsExpectation) Then(m1 map[int64][]string, err error) *PostQueryImpMock {
+	e.results = &PostQueryImpMockGetPostTagsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times PostQueryImp.GetPostTags should be invoked
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Times(n uint64) *mPostQueryImpMockGetPostTags {
+	if n == 0 {
+		mmGetPostTags.mock.t.Fatalf("Times of PostQueryImpMock.GetPostTags mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPostTags.expectedInvocations, n)
+	mmGetPostTags.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPostTags
+}
+
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) invocationsDone() bool {
+	if len(mmGetPostTags.expectations) == 0 && mmGetPostTags.defaultExpectation == nil && mmGetPostTags.mock.funcGetPostTags == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPostTags.mock.afterGetPostTagsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPostTags.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPostTags implements PostQueryImp
+func (mmGetPostTags *PostQueryImpMock) GetPostTags(ctx context.Context, postIDs []int64) (m1 map[int64][]string, err error) {
+	mm_atomic.AddUint64(&mmGetPostTags.beforeGetPostTagsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPostTags.afterGetPostTagsCounter, 1)
+
+	mmGetPostTags.t.Helper()
+
+	if mmGetPostTags.inspectFuncGetPostTags != nil {
+		mmGetPostTags.inspectFuncGetPostTags(ctx, postIDs)
+	}
+
+	mm_params := PostQueryImpMockGetPostTagsParams{ctx, postIDs}
+
+	// Record call args
+	mmGetPostTags.GetPostTagsMock.mutex.Lock()
+	mmGetPostTags.GetPostTagsMock.callArgs = append(mmGetPostTags.GetPostTagsMock.callArgs, &mm_params)
+	mmGetPostTags.GetPostTagsMock.mutex.Unlock()
+
+	for _, e := range mmGetPostTags.GetPostTagsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetPostTags.GetPostTagsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPostTags.GetPostTagsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPostTags.GetPostTagsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPostTags.GetPostTagsMock.defaultExpectation.paramPtrs
+
+		mm_got := PostQueryImpMockGetPostTagsParams{ctx, postIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPostTags.t.Errorf("PostQueryImpMock.GetPostTags got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostTags.GetPostTagsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postIDs != nil && !minimock.Equal(*mm_want_ptrs.postIDs, mm_got.postIDs) {
+				mmGetPostTags.t.Errorf("PostQueryImpMock.GetPostTags got unexpected parameter postIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostTags.GetPostTagsMock.defaultExpectation.expectationOrigins.originPostIDs, *mm_want_ptrs.postIDs, mm_got.postIDs, minimock.Diff(*mm_want_ptrs.postIDs, mm_got.postIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPostTags.t.Errorf("PostQueryImpMock.GetPostTags got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPostTags.GetPostTagsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPostTags.GetPostTagsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPostTags.t.Fatal("No results are set for the PostQueryImpMock.GetPostTags")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetPostTags.funcGetPostTags != nil {
+		return mmGetPostTags.funcGetPostTags(ctx, postIDs)
+	}
+	mmGetPostTags.t.Fatalf("Unexpected call to PostQueryImpMock.GetPostTags. %v %v", ctx, postIDs)
+	return
+}
+
+// GetPostTagsAfterCounter returns a count of finished PostQueryImpMock.GetPostTags invocations
+func (mmGetPostTags *PostQueryImpMock) GetPostTagsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostTags.afterGetPostTagsCounter)
+}
+
+// GetPostTagsBeforeCounter returns a count of PostQueryImpMock.GetPostTags invocations
+func (mmGetPostTags *PostQueryImpMock) GetPostTagsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostTags.beforeGetPostTagsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostQueryImpMock.GetPostTags.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPostTags *mPostQueryImpMockGetPostTags) Calls() []*PostQueryImpMockGetPostTagsParams {
+	mmGetPostTags.mutex.RLock()
+
+	argCopy := make([]*PostQueryImpMockGetPostTagsParams, len(mmGetPostTags.callArgs))
+	copy(argCopy, mmGetPostTags.callArgs)
+
+	mmGetPostTags.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPostTagsDone returns true if the count of the GetPostTags invocations corresponds
+// the number of defined expectations
+func (m *PostQueryImpMock) MinimockGetPostTagsDone() bool {
+	if m.GetPostTagsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPostTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPostTagsMock.invocationsDone()
+}
+
+// MinimockGetPostTagsInspect logs each unmet expectation
+func (m *PostQueryImpMock) MinimockGetPostTagsInspect() {
+	for _, e := range m.GetPostTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostTags at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPostTagsCounter := mm_atomic.LoadUint64(&m.afterGetPostTagsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPostTagsMock.defaultExpectation != nil && afterGetPostTagsCounter < 1 {
+		if m.GetPostTagsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostTags at\n%s", m.GetPostTagsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostTags at\n%s with params: %#v", m.GetPostTagsMock.defaultExpectation.expectationOrigins.origin, *m.GetPostTagsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPostTags != nil && afterGetPostTagsCounter < 1 {
+		m.t.Errorf("Expected call to PostQueryImpMock.GetPostTags at\n%s", m.funcGetPostTagsOrigin)
+	}
+
+	if !m.GetPostTagsMock.invocationsDone() && afterGetPostTagsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostQueryImpMock.GetPostTags at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPostTagsMock.expectedInvocations), m.GetPostTagsMock.expectedInvocationsOrigin, afterGetPostTagsCounter)
+	}
+}
+
+type mPostQueryImpMockGetPostVotes struct {
+	optional           bool
+	mock               *PostQueryImpMock
+	defaultExpectation *PostQueryImpMockGetPostVotesExpectation
+	expectations       []*PostQueryImpMockGetPostVotesExpectation
+
+	callArgs []*PostQueryImpMockGetPostVotesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostQueryImpMockGetPostVotesExpectation specifies expectation struct of the PostQueryImp.GetPostVotes
+type PostQueryImpMockGetPostVotesExpectation struct {
+	mock               *PostQueryImpMock
+	params             *PostQueryImpMockGetPostVotesParams
+	paramPtrs          *PostQueryImpMockGetPostVotesParamPtrs
+	expectationOrigins PostQueryImpMockGetPostVotesExpectationOrigins
+	results            *PostQueryImpMockGetPostVotesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostQueryImpMockGetPostVotesParams contains parameters of the PostQueryImp.GetPostVotes
+type PostQueryImpMockGetPostVotesParams struct {
+	ctx     context.Context
+	userID  uuid.UUID
+	postIDs []int64
+}
+
+// PostQueryImpMockGetPostVotesParamPtrs contains pointers to parameters of the PostQueryImp.GetPostVotes
+type PostQueryImpMockGetPostVotesParamPtrs struct {
+	ctx     *context.Context
+	userID  *uuid.UUID
+	postIDs *[]int64
+}
+
+// PostQueryImpMockGetPostVotesResults contains results of the PostQueryImp.GetPostVotes
+type PostQueryImpMockGetPostVotesResults struct {
+	m1  map[int64]model.VoteValue
+	err error
+}
+
+// PostQueryImpMockGetPostVotesOrigins contains origins of expectations of the PostQueryImp.GetPostVotes
+type PostQueryImpMockGetPostVotesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originPostIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Optional() *mPostQueryImpMockGetPostVotes {
+	mmGetPostVotes.optional = true
+	return mmGetPostVotes
+}
+
+// Expect sets up expected params for PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Expect(ctx context.Context, userID uuid.UUID, postIDs []int64) *mPostQueryImpMockGetPostVotes {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	if mmGetPostVotes.defaultExpectation == nil {
+		mmGetPostVotes.defaultExpectation = &PostQueryImpMockGetPostVotesExpectation{}
+	}
+
+	if mmGetPostVotes.defaultExpectation.paramPtrs != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by ExpectParams functions")
+	}
+
+	mmGetPostVotes.defaultExpectation.params = &PostQueryImpMockGetPostVotesParams{ctx, userID, postIDs}
+	mmGetPostVotes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPostVotes.expectations {
+		if minimock.Equal(e.params, mmGetPostVotes.defaultExpectation.params) {
+			mmGetPostVotes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPostVotes.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPostVotes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) ExpectCtxParam1(ctx context.Context) *mPostQueryImpMockGetPostVotes {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	if mmGetPostVotes.defaultExpectation == nil {
+		mmGetPostVotes.defaultExpectation = &PostQueryImpMockGetPostVotesExpectation{}
+	}
+
+	if mmGetPostVotes.defaultExpectation.params != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Expect")
+	}
+
+	if mmGetPostVotes.defaultExpectation.paramPtrs == nil {
+		mmGetPostVotes.defaultExpectation.paramPtrs = &PostQueryImpMockGetPostVotesParamPtrs{}
+	}
+	mmGetPostVotes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPostVotes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPostVotes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) ExpectUserIDParam2(userID uuid.UUID) *mPostQueryImpMockGetPostVotes {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	if mmGetPostVotes.defaultExpectation == nil {
+		mmGetPostVotes.defaultExpectation = &PostQueryImpMockGetPostVotesExpectation{}
+	}
+
+	if mmGetPostVotes.defaultExpectation.params != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Expect")
+	}
+
+	if mmGetPostVotes.defaultExpectation.paramPtrs == nil {
+		mmGetPostVotes.defaultExpectation.paramPtrs = &PostQueryImpMockGetPostVotesParamPtrs{}
+	}
+	mmGetPostVotes.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPostVotes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPostVotes
+}
+
+// ExpectPostIDsParam3 sets up expected param postIDs for PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) ExpectPostIDsParam3(postIDs []int64) *mPostQueryImpMockGetPostVotes {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	if mmGetPostVotes.defaultExpectation == nil {
+		mmGetPostVotes.defaultExpectation = &PostQueryImpMockGetPostVotesExpectation{}
+	}
+
+	if mmGetPostVotes.defaultExpectation.params != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Expect")
+	}
+
+	if mmGetPostVotes.defaultExpectation.paramPtrs == nil {
+		mmGetPostVotes.defaultExpectation.paramPtrs = &PostQueryImpMockGetPostVotesParamPtrs{}
+	}
+	mmGetPostVotes.defaultExpectation.paramPtrs.postIDs = &postIDs
+	mmGetPostVotes.defaultExpectation.expectationOrigins.originPostIDs = minimock.CallerInfo(1)
+
+	return mmGetPostVotes
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Inspect(f func(ctx context.Context, userID uuid.UUID, postIDs []int64)) *mPostQueryImpMockGetPostVotes {
+	if mmGetPostVotes.mock.inspectFuncGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("Inspect function is already set for PostQueryImpMock.GetPostVotes")
+	}
+
+	mmGetPostVotes.mock.inspectFuncGetPostVotes = f
+
+	return mmGetPostVotes
+}
+
+// Return sets up results that will be returned by PostQueryImp.GetPostVotes
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Return(m1 map[int64]model.VoteValue, err error) *PostQueryImpMock {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	if mmGetPostVotes.defaultExpectation == nil {
+		mmGetPostVotes.defaultExpectation = &PostQueryImpMockGetPostVotesExpectation{mock: mmGetPostVotes.mock}
+	}
+	mmGetPostVotes.defaultExpectation.results = &PostQueryImpMockGetPostVotesResults{m1, err}
+	mmGetPostVotes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPostVotes.mock
+}
+
+// Set uses given function f to mock the PostQueryImp.GetPostVotes method
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Set(f func(ctx context.Context, userID uuid.UUID, postIDs []int64) (m1 map[int64]model.VoteValue, err error)) *PostQueryImpMock {
+	if mmGetPostVotes.defaultExpectation != nil {
+		mmGetPostVotes.mock.t.Fatalf("Default expectation is already set for the PostQueryImp.GetPostVotes method")
+	}
+
+	if len(mmGetPostVotes.expectations) > 0 {
+		mmGetPostVotes.mock.t.Fatalf("Some expectations are already set for the PostQueryImp.GetPostVotes method")
+	}
+
+	mmGetPostVotes.mock.funcGetPostVotes = f
+	mmGetPostVotes.mock.funcGetPostVotesOrigin = minimock.CallerInfo(1)
+	return mmGetPostVotes.mock
+}
+
+// When sets expectation for the PostQueryImp.GetPostVotes which will trigger the result defined by the following
+// Then helper
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) When(ctx context.Context, userID uuid.UUID, postIDs []int64) *PostQueryImpMockGetPostVotesExpectation {
+	if mmGetPostVotes.mock.funcGetPostVotes != nil {
+		mmGetPostVotes.mock.t.Fatalf("PostQueryImpMock.GetPostVotes mock is already set by Set")
+	}
+
+	expectation := &PostQueryImpMockGetPostVotesExpectation{
+		mock:               mmGetPostVotes.mock,
+		params:             &PostQueryImpMockGetPostVotesParams{ctx, userID, postIDs},
+		expectationOrigins: PostQueryImpMockGetPostVotesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPostVotes.expectations = append(mmGetPostVotes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostQueryImp.GetPostVotes return parameters for the expectation previously defined by the When method
+func (e *PostQueryImpMockGetPostVotesExpectation) Then(m1 map[int64]model.VoteValue, err error) *PostQueryImpMock {
+	e.results = &PostQueryImpMockGetPostVotesResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times PostQueryImp.GetPostVotes should be invoked
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Times(n uint64) *mPostQueryImpMockGetPostVotes {
+	if n == 0 {
+		mmGetPostVotes.mock.t.Fatalf("Times of PostQueryImpMock.GetPostVotes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPostVotes.expectedInvocations, n)
+	mmGetPostVotes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPostVotes
+}
+
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) invocationsDone() bool {
+	if len(mmGetPostVotes.expectations) == 0 && mmGetPostVotes.defaultExpectation == nil && mmGetPostVotes.mock.funcGetPostVotes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPostVotes.mock.afterGetPostVotesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPostVotes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPostVotes implements PostQueryImp
+func (mmGetPostVotes *PostQueryImpMock) GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (m1 map[int64]model.VoteValue, err error) {
+	mm_atomic.AddUint64(&mmGetPostVotes.beforeGetPostVotesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPostVotes.afterGetPostVotesCounter, 1)
+
+	mmGetPostVotes.t.Helper()
+
+	if mmGetPostVotes.inspectFuncGetPostVotes != nil {
+		mmGetPostVotes.inspectFuncGetPostVotes(ctx, userID, postIDs)
+	}
+
+	mm_params := PostQueryImpMockGetPostVotesParams{ctx, userID, postIDs}
+
+	// Record call args
+	mmGetPostVotes.GetPostVotesMock.mutex.Lock()
+	mmGetPostVotes.GetPostVotesMock.callArgs = append(mmGetPostVotes.GetPostVotesMock.callArgs, &mm_params)
+	mmGetPostVotes.GetPostVotesMock.mutex.Unlock()
+
+	for _, e := range mmGetPostVotes.GetPostVotesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetPostVotes.GetPostVotesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPostVotes.GetPostVotesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPostVotes.GetPostVotesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPostVotes.GetPostVotesMock.defaultExpectation.paramPtrs
+
+		mm_got := PostQueryImpMockGetPostVotesParams{ctx, userID, postIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPostVotes.t.Errorf("PostQueryImpMock.GetPostVotes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostVotes.GetPostVotesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPostVotes.t.Errorf("PostQueryImpMock.GetPostVotes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostVotes.GetPostVotesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.postIDs != nil && !minimock.Equal(*mm_want_ptrs.postIDs, mm_got.postIDs) {
+				mmGetPostVotes.t.Errorf("PostQueryImpMock.GetPostVotes got unexpected parameter postIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostVotes.GetPostVotesMock.defaultExpectation.expectationOrigins.originPostIDs, *mm_want_ptrs.postIDs, mm_got.postIDs, minimock.Diff(*mm_want_ptrs.postIDs, mm_got.postIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPostVotes.t.Errorf("PostQueryImpMock.GetPostVotes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPostVotes.GetPostVotesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPostVotes.GetPostVotesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPostVotes.t.Fatal("No results are set for the PostQueryImpMock.GetPostVotes")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetPostVotes.funcGetPostVotes != nil {
+		return mmGetPostVotes.funcGetPostVotes(ctx, userID, postIDs)
+	}
+	mmGetPostVotes.t.Fatalf("Unexpected call to PostQueryImpMock.GetPostVotes. %v %v %v", ctx, userID, postIDs)
+	return
+}
+
+// GetPostVotesAfterCounter returns a count of finished PostQueryImpMock.GetPostVotes invocations
+func (mmGetPostVotes *PostQueryImpMock) GetPostVotesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostVotes.afterGetPostVotesCounter)
+}
+
+// GetPostVotesBeforeCounter returns a count of PostQueryImpMock.GetPostVotes invocations
+func (mmGetPostVotes *PostQueryImpMock) GetPostVotesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostVotes.beforeGetPostVotesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostQueryImpMock.GetPostVotes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPostVotes *mPostQueryImpMockGetPostVotes) Calls() []*PostQueryImpMockGetPostVotesParams {
+	mmGetPostVotes.mutex.RLock()
+
+	argCopy := make([]*PostQueryImpMockGetPostVotesParams, len(mmGetPostVotes.callArgs))
+	copy(argCopy, mmGetPostVotes.callArgs)
+
+	mmGetPostVotes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPostVotesDone returns true if the count of the GetPostVotes invocations corresponds
+// the number of defined expectations
+func (m *PostQueryImpMock) MinimockGetPostVotesDone() bool {
+	if m.GetPostVotesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPostVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPostVotesMock.invocationsDone()
+}
+
+// MinimockGetPostVotesInspect logs each unmet expectation
+func (m *PostQueryImpMock) MinimockGetPostVotesInspect() {
+	for _, e := range m.GetPostVotesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostVotes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPostVotesCounter := mm_atomic.LoadUint64(&m.afterGetPostVotesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPostVotesMock.defaultExpectation != nil && afterGetPostVotesCounter < 1 {
+		if m.GetPostVotesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostVotes at\n%s", m.GetPostVotesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetPostVotes at\n%s with params: %#v", m.GetPostVotesMock.defaultExpectation.expectationOrigins.origin, *m.GetPostVotesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPostVotes != nil && afterGetPostVotesCounter < 1 {
+		m.t.Errorf("Expected call to PostQueryImpMock.GetPostVotes at\n%s", m.funcGetPostVotesOrigin)
+	}
+
+	if !m.GetPostVotesMock.invocationsDone() && afterGetPostVotesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostQueryImpMock.GetPostVotes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPostVotesMock.expectedInvocations), m.GetPostVotesMock.expectedInvocationsOrigin, afterGetPostVotesCounter)
+	}
+}
+
+type mPostQueryImpMockGetTags struct {
+	optional           bool
+	mock               *PostQueryImpMock
+	defaultExpectation *PostQueryImpMockGetTagsExpectation
+	expectations       []*PostQueryImpMockGetTagsExpectation
+
+	callArgs []*PostQueryImpMockGetTagsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PostQueryImpMockGetTagsExpectation specifies expectation struct of the PostQueryImp.GetTags
+type PostQueryImpMockGetTagsExpectation struct {
+	mock               *PostQueryImpMock
+	params             *PostQueryImpMockGetTagsParams
+	paramPtrs          *PostQueryImpMockGetTagsParamPtrs
+	expectationOrigins PostQueryImpMockGetTagsExpectationOrigins
+	results            *PostQueryImpMockGetTagsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PostQueryImpMockGetTagsParams contains parameters of the PostQueryImp.GetTags
+type PostQueryImpMockGetTagsParams struct {
+	ctx context.Context
+}
+
+// PostQueryImpMockGetTagsParamPtrs contains pointers to parameters of the PostQueryImp.GetTags
+type PostQueryImpMockGetTagsParamPtrs struct {
+	ctx *context.Context
+}
+
+// PostQueryImpMockGetTagsResults contains results of the PostQueryImp.GetTags
+type PostQueryImpMockGetTagsResults struct {
+	ta1 []model.Tag
+	err error
+}
+
+// PostQueryImpMockGetTagsOrigins contains origins of expectations of the PostQueryImp.GetTags
+type PostQueryImpMockGetTagsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTags *mPostQueryImpMockGetTags) Optional() *mPostQueryImpMockGetTags {
+	mmGetTags.optional = true
+	return mmGetTags
+}
+
+// Expect sets up expected params for PostQueryImp.GetTags
+func (mmGetTags *mPostQueryImpMockGetTags) Expect(ctx context.Context) *mPostQueryImpMockGetTags {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &PostQueryImpMockGetTagsExpectation{}
+	}
+
+	if mmGetTags.defaultExpectation.paramPtrs != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by ExpectParams functions")
+	}
+
+	mmGetTags.defaultExpectation.params = &PostQueryImpMockGetTagsParams{ctx}
+	mmGetTags.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTags.expectations {
+		if minimock.Equal(e.params, mmGetTags.defaultExpectation.params) {
+			mmGetTags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTags.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTags
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PostQueryImp.GetTags
+func (mmGetTags *mPostQueryImpMockGetTags) ExpectCtxParam1(ctx context.Context) *mPostQueryImpMockGetTags {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &PostQueryImpMockGetTagsExpectation{}
+	}
+
+	if mmGetTags.defaultExpectation.params != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by Expect")
+	}
+
+	if mmGetTags.defaultExpectation.paramPtrs == nil {
+		mmGetTags.defaultExpectation.paramPtrs = &PostQueryImpMockGetTagsParamPtrs{}
+	}
+	mmGetTags.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTags.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTags
+}
+
+// Inspect accepts an inspector function that has same arguments as the PostQueryImp.GetTags
+func (mmGetTags *mPostQueryImpMockGetTags) Inspect(f func(ctx context.Context)) *mPostQueryImpMockGetTags {
+	if mmGetTags.mock.inspectFuncGetTags != nil {
+		mmGetTags.mock.t.Fatalf("Inspect function is already set for PostQueryImpMock.GetTags")
+	}
+
+	mmGetTags.mock.inspectFuncGetTags = f
+
+	return mmGetTags
+}
+
+// Return sets up results that will be returned by PostQueryImp.GetTags
+func (mmGetTags *mPostQueryImpMockGetTags) Return(ta1 []model.Tag, err error) *PostQueryImpMock {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &PostQueryImpMockGetTagsExpectation{mock: mmGetTags.mock}
+	}
+	mmGetTags.defaultExpectation.results = &PostQueryImpMockGetTagsResults{ta1, err}
+	mmGetTags.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTags.mock
+}
+
+// Set uses given function f to mock the PostQueryImp.GetTags method
+func (mmGetTags *mPostQueryImpMockGetTags) Set(f func(ctx context.Context) (ta1 []model.Tag, err error)) *PostQueryImpMock {
+	if mmGetTags.defaultExpectation != nil {
+		mmGetTags.mock.t.Fatalf("Default expectation is already set for the PostQueryImp.GetTags method")
+	}
+
+	if len(mmGetTags.expectations) > 0 {
+		mmGetTags.mock.t.Fatalf("Some expectations are already set for the PostQueryImp.GetTags method")
+	}
+
+	mmGetTags.mock.funcGetTags = f
+	mmGetTags.mock.funcGetTagsOrigin = minimock.CallerInfo(1)
+	return mmGetTags.mock
+}
+
+// When sets expectation for the PostQueryImp.GetTags which will trigger the result defined by the following
+// Then helper
+func (mmGetTags *mPostQueryImpMockGetTags) When(ctx context.Context) *PostQueryImpMockGetTagsExpectation {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("PostQueryImpMock.GetTags mock is already set by Set")
+	}
+
+	expectation := &PostQueryImpMockGetTagsExpectation{
+		mock:               mmGetTags.mock,
+		params:             &PostQueryImpMockGetTagsParams{ctx},
+		expectationOrigins: PostQueryImpMockGetTagsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTags.expectations = append(mmGetTags.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PostQueryImp.GetTags return parameters for the expectation previously defined by the When method
+func (e *PostQueryImpMockGetTagsExpectation) Then(ta1 []model.Tag, err error) *PostQueryImpMock {
+	e.results = &PostQueryImpMockGetTagsResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times PostQueryImp.GetTags should be invoked
+func (mmGetTags *mPostQueryImpMockGetTags) Times(n uint64) *mPostQueryImpMockGetTags {
+	if n == 0 {
+		mmGetTags.mock.t.Fatalf("Times of PostQueryImpMock.GetTags mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTags.expectedInvocations, n)
+	mmGetTags.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTags
+}
+
+func (mmGetTags *mPostQueryImpMockGetTags) invocationsDone() bool {
+	if len(mmGetTags.expectations) == 0 && mmGetTags.defaultExpectation == nil && mmGetTags.mock.funcGetTags == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTags.mock.afterGetTagsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTags.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTags implements PostQueryImp
+func (mmGetTags *PostQueryImpMock) GetTags(ctx context.Context) (ta1 []model.Tag, err error) {
+	mm_atomic.AddUint64(&mmGetTags.beforeGetTagsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTags.afterGetTagsCounter, 1)
+
+	mmGetTags.t.Helper()
+
+	if mmGetTags.inspectFuncGetTags != nil {
+		mmGetTags.inspectFuncGetTags(ctx)
+	}
+
+	mm_params := PostQueryImpMockGetTagsParams{ctx}
+
+	// Record call args
+	mmGetTags.GetTagsMock.mutex.Lock()
+	mmGetTags.GetTagsMock.callArgs = append(mmGetTags.GetTagsMock.callArgs, &mm_params)
+	mmGetTags.GetTagsMock.mutex.Unlock()
+
+	for _, e := range mmGetTags.GetTagsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmGetTags.GetTagsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTags.GetTagsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTags.GetTagsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTags.GetTagsMock.defaultExpectation.paramPtrs
+
+		mm_got := PostQueryImpMockGetTagsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTags.t.Errorf("PostQueryImpMock.GetTags got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTags.GetTagsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTags.t.Errorf("PostQueryImpMock.GetTags got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTags.GetTagsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTags.GetTagsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTags.t.Fatal("No results are set for the PostQueryImpMock.GetTags")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmGetTags.funcGetTags != nil {
+		return mmGetTags.funcGetTags(ctx)
+	}
+	mmGetTags.t.Fatalf("Unexpected call to PostQueryImpMock.GetTags. %v", ctx)
+	return
+}
+
+// GetTagsAfterCounter returns a count of finished PostQueryImpMock.GetTags invocations
+func (mmGetTags *PostQueryImpMock) GetTagsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTags.afterGetTagsCounter)
+}
+
+// GetTagsBeforeCounter returns a count of PostQueryImpMock.GetTags invocations
+func (mmGetTags *PostQueryImpMock) GetTagsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTags.beforeGetTagsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PostQueryImpMock.GetTags.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTags *mPostQueryImpMockGetTags) Calls() []*PostQueryImpMockGetTagsParams {
+	mmGetTags.mutex.RLock()
+
+	argCopy := make([]*PostQueryImpMockGetTagsParams, len(mmGetTags.callArgs))
+	copy(argCopy, mmGetTags.callArgs)
+
+	mmGetTags.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTagsDone returns true if the count of the GetTags invocations corresponds
+// the number of defined expectations
+func (m *PostQueryImpMock) MinimockGetTagsDone() bool {
+	if m.GetTagsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTagsMock.invocationsDone()
+}
+
+// MinimockGetTagsInspect logs each unmet expectation
+func (m *PostQueryImpMock) MinimockGetTagsInspect() {
+	for _, e := range m.GetTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetTags at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTagsCounter := mm_atomic.LoadUint64(&m.afterGetTagsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTagsMock.defaultExpectation != nil && afterGetTagsCounter < 1 {
+		if m.GetTagsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetTags at\n%s", m.GetTagsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PostQueryImpMock.GetTags at\n%s with params: %#v", m.GetTagsMock.defaultExpectation.expectationOrigins.origin, *m.GetTagsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTags != nil && afterGetTagsCounter < 1 {
+		m.t.Errorf("Expected call to PostQueryImpMock.GetTags at\n%s", m.funcGetTagsOrigin)
+	}
+
+	if !m.GetTagsMock.invocationsDone() && afterGetTagsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PostQueryImpMock.GetTags at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTagsMock.expectedInvocations), m.GetTagsMock.expectedInvocationsOrigin, afterGetTagsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PostQueryImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAllPostsInspect()
+
+			m.MinimockGetPostInspect()
+
+			m.MinimockGetPostTagsInspect()
+
+			m.MinimockGetPostVotesInspect()
+
+			m.MinimockGetTagsInspect()
 		}
 	})
 }
@@ -739,5 +1836,8 @@ func (m *PostQueryImpMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockGetAllPostsDone() &&
-		m.MinimockGetPostDone()
+		m.MinimockGetPostDone() &&
+		m.MinimockGetPostTagsDone() &&
+		m.MinimockGetPostVotesDone() &&
+		m.MinimockGetTagsDone()
 }
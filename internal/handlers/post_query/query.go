@@ -6,6 +6,8 @@ import (
 
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
 	"github.com/rs/zerolog/log"
 )
 
@@ -17,12 +19,26 @@ func NewPostQuery(postQueryImp PostQueryImp) *PostQuery {
 	return &PostQuery{postQueryImp: postQueryImp}
 }
 
-func (h *PostQuery) GetAllPosts(ctx context.Context) ([]*model.Post, error) {
+func (h *PostQuery) GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error) {
 	op := "internal.handlers.postquery.GetAllPosts()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	posts, err := h.postQueryImp.GetAllPosts(ctx)
+	filter := opts.Filter
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return nil, errs.ErrInvalidPostFilter
+	}
+
+	// a blank tag would silently select all posts
+	tag := model.NormalizeTag(opts.Tag)
+	if opts.Tag != "" && tag == "" {
+		var v validate.Validator
+		v.Add("tag", "must not be blank")
+		return nil, v.Err()
+	}
+	opts.Tag = tag
+	opts.ViewerID, _ = viewer.FromContext(ctx)
+	posts, err := h.postQueryImp.GetAllPosts(ctx, opts)
 
 	if err != nil {
 		if err == errs.ErrPostsNotExist {
@@ -31,14 +47,14 @@ func (h *PostQuery) GetAllPosts(ctx context.Context) ([]*model.Post, error) {
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return posts, nil
 }
 
 func (h *PostQuery) GetPost(ctx context.Context, postID int64) (*model.Post, error) {
 	op := "internal.handlers.postquery.GetPostWithComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	post, err := h.postQueryImp.GetPost(ctx, postID)
 
@@ -49,6 +65,78 @@ func (h *PostQuery) GetPost(ctx context.Context, postID int64) (*model.Post, err
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	// the drafts and the scheduled posts don't exist for anyone but the author
+	viewerID, _ := viewer.FromContext(ctx)
+	if !post.VisibleTo(viewerID) {
+		return nil, errs.ErrPostNotExist
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, nil
 }
+
+// GetMyVote returns the vote of the viewer for the post, ok is false when the
+// request is anonymous.
+func (h *PostQuery) GetMyVote(ctx context.Context, postID int64) (vote model.VoteValue, ok bool, err error) {
+	op := "internal.handlers.postquery.GetMyVote()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	userID, ok := viewer.FromContext(ctx)
+	if !ok {
+		return model.VoteNone, false, nil
+	}
+
+	if l := loadersFromContext(ctx); l != nil {
+		vote, err = l.votes.Load(ctx, postID)
+	} else {
+		var votes map[int64]model.VoteValue
+		votes, err = h.postQueryImp.GetPostVotes(ctx, userID, []int64{postID})
+		vote = votes[postID]
+	}
+	if err != nil {
+		return model.VoteNone, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return vote, true, nil
+}
+
+// GetPostTags returns the tags of the post in alphabetical order.
+func (h *PostQuery) GetPostTags(ctx context.Context, postID int64) ([]string, error) {
+	op := "internal.handlers.postquery.GetPostTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var (
+		tags []string
+		err  error
+	)
+	if l := loadersFromContext(ctx); l != nil {
+		tags, err = l.tags.Load(ctx, postID)
+	} else {
+		var byPost map[int64][]string
+		byPost, err = h.postQueryImp.GetPostTags(ctx, []int64{postID})
+		tags = byPost[postID]
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+func (h *PostQuery) GetTags(ctx context.Context) ([]model.Tag, error) {
+	op := "internal.handlers.postquery.GetTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	tags, err := h.postQueryImp.GetTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
@@ -3,12 +3,15 @@ package postquery
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,8 +33,10 @@ func TestPostQuery(t *testing.T) {
 			},
 		}
 
-		postQueryImpMock.GetAllPostsMock.Expect(ctx).Return(expectedPosts, nil)
-		posts, err := handler.GetAllPosts(ctx)
+		opts := model.PostsOptions{OrderBy: model.PostOrderCommentsCount, Desc: true}
+
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, opts).Return(expectedPosts, nil)
+		posts, err := handler.GetAllPosts(ctx, opts)
 		assert.NoError(t, err)
 		assert.Equal(t, posts, expectedPosts)
 	})
@@ -39,8 +44,8 @@ func TestPostQuery(t *testing.T) {
 	t.Run("Error posts not exist", func(t *testing.T) {
 		ctx := context.Background()
 
-		postQueryImpMock.GetAllPostsMock.Expect(ctx).Return(nil, errs.ErrPostsNotExist)
-		posts, err := handler.GetAllPosts(ctx)
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, model.PostsOptions{}).Return(nil, errs.ErrPostsNotExist)
+		posts, err := handler.GetAllPosts(ctx, model.PostsOptions{})
 		assert.Nil(t, posts)
 		assert.Equal(t, err, errs.ErrPostsNotExist)
 	})
@@ -48,8 +53,8 @@ func TestPostQuery(t *testing.T) {
 	t.Run("Unexpected error", func(t *testing.T) {
 		ctx := context.Background()
 
-		postQueryImpMock.GetAllPostsMock.Expect(ctx).Return(nil, errors.New("unexpected error"))
-		posts, err := handler.GetAllPosts(ctx)
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, model.PostsOptions{}).Return(nil, errors.New("unexpected error"))
+		posts, err := handler.GetAllPosts(ctx, model.PostsOptions{})
 		assert.Nil(t, posts)
 		assert.NotNil(t, err)
 	})
@@ -64,6 +69,7 @@ func TestPostQuery(t *testing.T) {
 			Title:           "Test Title",
 			Text:            "Test Content",
 			CommentsEnabled: true,
+			Status:          model.PostPublished,
 		}
 
 		postQueryImpMock.GetPostMock.Expect(ctx, postID).Return(expectedPost, nil)
@@ -72,6 +78,32 @@ func TestPostQuery(t *testing.T) {
 		assert.Equal(t, post, expectedPost)
 	})
 
+	t.Run("Draft is visible only to its author", func(t *testing.T) {
+		authorID := uuid.New()
+		draft := &model.Post{ID: 2, AuthorID: authorID, Status: model.PostDraft}
+
+		ctx := viewer.NewContext(context.Background(), authorID)
+		postQueryImpMock.GetPostMock.Expect(ctx, int64(2)).Return(draft, nil)
+		post, err := handler.GetPost(ctx, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, draft, post)
+
+		ctx = viewer.NewContext(context.Background(), uuid.New())
+		postQueryImpMock.GetPostMock.Expect(ctx, int64(2)).Return(draft, nil)
+		post, err = handler.GetPost(ctx, 2)
+		assert.Equal(t, errs.ErrPostNotExist, err)
+		assert.Nil(t, post)
+	})
+
+	t.Run("Posts are listed for the viewer", func(t *testing.T) {
+		viewerID := uuid.New()
+		ctx := viewer.NewContext(context.Background(), viewerID)
+
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, model.PostsOptions{ViewerID: viewerID}).Return([]*model.Post{}, nil)
+		_, err := handler.GetAllPosts(ctx, model.PostsOptions{})
+		assert.NoError(t, err)
+	})
+
 	t.Run("Error post not exist", func(t *testing.T) {
 		ctx := context.Background()
 		postID := int64(123) // not existing post ID
@@ -92,3 +124,138 @@ func TestPostQuery(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestPostQueryMyVote(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	postQueryImpMock := NewPostQueryImpMock(mc)
+	handler := PostQuery{postQueryImp: postQueryImpMock}
+
+	t.Run("Anonymous request has no vote", func(t *testing.T) {
+		vote, ok, err := handler.GetMyVote(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, model.VoteNone, vote)
+	})
+
+	t.Run("Votes of the viewer are loaded in one batch", func(t *testing.T) {
+		userID := uuid.New()
+		ctx := handler.WithLoaders(viewer.NewContext(context.Background(), userID))
+
+		postQueryImpMock.GetPostVotesMock.Set(func(ctx context.Context, id uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error) {
+			assert.Equal(t, userID, id)
+			assert.ElementsMatch(t, []int64{1, 2}, postIDs)
+			return map[int64]model.VoteValue{1: model.VoteUp}, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			vote, ok, err := handler.GetMyVote(ctx, 1)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, model.VoteUp, vote)
+		}()
+		go func() {
+			defer wg.Done()
+			vote, ok, err := handler.GetMyVote(ctx, 2)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, model.VoteNone, vote)
+		}()
+		wg.Wait()
+
+		assert.Equal(t, uint64(1), postQueryImpMock.GetPostVotesAfterCounter())
+	})
+}
+
+func TestPostQueryTags(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	postQueryImpMock := NewPostQueryImpMock(mc)
+	handler := PostQuery{postQueryImp: postQueryImpMock}
+
+	t.Run("Tag filter is normalized", func(t *testing.T) {
+		ctx := context.Background()
+
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, model.PostsOptions{Tag: "go lang"}).Return([]*model.Post{}, nil)
+		posts, err := handler.GetAllPosts(ctx, model.PostsOptions{Tag: "  Go   Lang "})
+		assert.NoError(t, err)
+		assert.Empty(t, posts)
+	})
+
+	t.Run("Error blank tag filter", func(t *testing.T) {
+		posts, err := handler.GetAllPosts(context.Background(), model.PostsOptions{Tag: "   "})
+		assert.ErrorIs(t, err, errs.ErrInvalidInput)
+
+		var validationErr *errs.ValidationError
+		if assert.ErrorAs(t, err, &validationErr) {
+			assert.Equal(t, "tag", validationErr.Fields[0].Field)
+		}
+		assert.Nil(t, posts)
+	})
+
+	t.Run("Tags of the posts are loaded in one batch", func(t *testing.T) {
+		ctx := handler.WithLoaders(context.Background())
+
+		postQueryImpMock.GetPostTagsMock.Set(func(ctx context.Context, postIDs []int64) (map[int64][]string, error) {
+			assert.ElementsMatch(t, []int64{1, 2}, postIDs)
+			return map[int64][]string{1: {"go", "habr"}}, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			tags, err := handler.GetPostTags(ctx, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"go", "habr"}, tags)
+		}()
+		go func() {
+			defer wg.Done()
+			tags, err := handler.GetPostTags(ctx, 2)
+			assert.NoError(t, err)
+			assert.Empty(t, tags)
+		}()
+		wg.Wait()
+
+		assert.Equal(t, uint64(1), postQueryImpMock.GetPostTagsAfterCounter())
+	})
+
+	t.Run("Unexpected error from get tags", func(t *testing.T) {
+		ctx := context.Background()
+
+		postQueryImpMock.GetTagsMock.Expect(ctx).Return(nil, errors.New("unexpected error"))
+		tags, err := handler.GetTags(ctx)
+		assert.NotNil(t, err)
+		assert.Nil(t, tags)
+	})
+}
+
+func TestPostQueryFilter(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	postQueryImpMock := NewPostQueryImpMock(mc)
+	handler := PostQuery{postQueryImp: postQueryImpMock}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("Filter is passed to the storage", func(t *testing.T) {
+		ctx := context.Background()
+		authorID := uuid.New()
+		opts := model.PostsOptions{Filter: model.PostFilter{AuthorID: &authorID, CreatedAfter: &from, CreatedBefore: &to}}
+
+		postQueryImpMock.GetAllPostsMock.Expect(ctx, opts).Return([]*model.Post{}, nil)
+		posts, err := handler.GetAllPosts(ctx, opts)
+		assert.NoError(t, err)
+		assert.Empty(t, posts)
+	})
+
+	t.Run("Error empty date range", func(t *testing.T) {
+		posts, err := handler.GetAllPosts(context.Background(), model.PostsOptions{Filter: model.PostFilter{CreatedAfter: &to, CreatedBefore: &from}})
+		assert.Equal(t, errs.ErrInvalidPostFilter, err)
+		assert.Nil(t, posts)
+	})
+}
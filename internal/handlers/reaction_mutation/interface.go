@@ -0,0 +1,14 @@
+package reactionmutation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+//go:generate minimock -i ReactionMutationImp
+type ReactionMutationImp interface {
+	AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error)
+	RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error)
+}
@@ -0,0 +1,81 @@
+package reactionmutation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/validate"
+	"github.com/rs/zerolog/log"
+)
+
+type ReactionMutation struct {
+	reactionMutationImp ReactionMutationImp
+	allowed             map[string]struct{}
+}
+
+// NewReactionMutation returns the handler accepting only the allowed emoji.
+func NewReactionMutation(reactionMutationImp ReactionMutationImp, allowed []string) *ReactionMutation {
+	h := &ReactionMutation{
+		reactionMutationImp: reactionMutationImp,
+		allowed:             make(map[string]struct{}, len(allowed)),
+	}
+	for _, emoji := range allowed {
+		h.allowed[emoji] = struct{}{}
+	}
+	return h
+}
+
+// AddReaction adds the reaction of the user to the post or the comment and
+// returns the id of the post the target belongs to, changed is false when the
+// user already reacted with the emoji.
+func (h *ReactionMutation) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.handlers.reactionmutation.AddReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(userID); err != nil {
+		return 0, false, err
+	}
+
+	if _, ok := h.allowed[emoji]; !ok {
+		return 0, false, errs.ErrReactionNotAllowed
+	}
+
+	postID, changed, err := h.reactionMutationImp.AddReaction(ctx, key, userID, emoji)
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrCommentNotExist {
+			return 0, false, err
+		}
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, changed, nil
+}
+
+// RemoveReaction removes the reaction of the user, the emoji isn't checked, so
+// reactions that are no longer allowed can still be removed. changed is false
+// when there was no such reaction.
+func (h *ReactionMutation) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.handlers.reactionmutation.RemoveReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	if err := validate.Author(userID); err != nil {
+		return 0, false, err
+	}
+
+	postID, changed, err := h.reactionMutationImp.RemoveReaction(ctx, key, userID, emoji)
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrCommentNotExist {
+			return 0, false, err
+		}
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, changed, nil
+}
@@ -0,0 +1,84 @@
+package reactionmutation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionMutation(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	reactionMutationImpMock := NewReactionMutationImpMock(mc)
+	handler := NewReactionMutation(reactionMutationImpMock, []string{"👍", "🔥"})
+
+	userID := uuid.New()
+	postKey := model.ReactionKey{Target: model.ReactionTargetPost, ID: 1}
+	commentKey := model.ReactionKey{Target: model.ReactionTargetComment, ID: 7}
+
+	t.Run("Successfully add reaction", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.AddReactionMock.Expect(ctx, commentKey, userID, "🔥").Return(3, true, nil)
+		postID, changed, err := handler.AddReaction(ctx, commentKey, userID, "🔥")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), postID)
+		assert.True(t, changed)
+	})
+
+	t.Run("Repeated reaction changes nothing", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.AddReactionMock.Expect(ctx, commentKey, userID, "🔥").Return(3, false, nil)
+		postID, changed, err := handler.AddReaction(ctx, commentKey, userID, "🔥")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), postID)
+		assert.False(t, changed)
+	})
+
+	t.Run("Error reaction not allowed", func(t *testing.T) {
+		postID, _, err := handler.AddReaction(context.Background(), postKey, userID, "💩")
+		assert.Equal(t, errs.ErrReactionNotAllowed, err)
+		assert.Zero(t, postID)
+	})
+
+	t.Run("Error post not exist", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.AddReactionMock.Expect(ctx, postKey, userID, "👍").Return(0, false, errs.ErrPostNotExist)
+		_, _, err := handler.AddReaction(ctx, postKey, userID, "👍")
+		assert.Equal(t, errs.ErrPostNotExist, err)
+	})
+
+	t.Run("Unexpected error from add reaction", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.AddReactionMock.Expect(ctx, postKey, userID, "👍").Return(0, false, errors.New("unexpected error"))
+		_, _, err := handler.AddReaction(ctx, postKey, userID, "👍")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Reaction that is no longer allowed can be removed", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.RemoveReactionMock.Expect(ctx, postKey, userID, "💩").Return(1, true, nil)
+		postID, changed, err := handler.RemoveReaction(ctx, postKey, userID, "💩")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), postID)
+		assert.True(t, changed)
+	})
+
+	t.Run("Error comment not exist", func(t *testing.T) {
+		ctx := context.Background()
+
+		reactionMutationImpMock.RemoveReactionMock.Expect(ctx, commentKey, userID, "👍").Return(0, false, errs.ErrCommentNotExist)
+		_, _, err := handler.RemoveReaction(ctx, commentKey, userID, "👍")
+		assert.Equal(t, errs.ErrCommentNotExist, err)
+	})
+}
@@ -0,0 +1,901 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package reactionmutation
+
+//go:generate minimock -i github.com/nabishec/ozon_habr_api/internal/handlers/reaction_mutation.ReactionMutationImp -o reaction_mutation_imp_mock_test.go -n ReactionMutationImpMock -p reactionmutation
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+// ReactionMutationImpMock implements ReactionMutationImp
+type ReactionMutationImpMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddReaction          func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mReactionMutationImpMockAddReaction
+
+	funcRemoveReaction          func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mReactionMutationImpMockRemoveReaction
+}
+
+// NewReactionMutationImpMock returns a mock for ReactionMutationImp
+func NewReactionMutationImpMock(t minimock.Tester) *ReactionMutationImpMock {
+	m := &ReactionMutationImpMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddReactionMock = mReactionMutationImpMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ReactionMutationImpMockAddReactionParams{}
+
+	m.RemoveReactionMock = mReactionMutationImpMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ReactionMutationImpMockRemoveReactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReactionMutationImpMockAddReaction struct {
+	optional           bool
+	mock               *ReactionMutationImpMock
+	defaultExpectation *ReactionMutationImpMockAddReactionExpectation
+	expectations       []*ReactionMutationImpMockAddReactionExpectation
+
+	callArgs []*ReactionMutationImpMockAddReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionMutationImpMockAddReactionExpectation specifies expectation struct of the ReactionMutationImp.AddReaction
+type ReactionMutationImpMockAddReactionExpectation struct {
+	mock               *ReactionMutationImpMock
+	params             *ReactionMutationImpMockAddReactionParams
+	paramPtrs          *ReactionMutationImpMockAddReactionParamPtrs
+	expectationOrigins ReactionMutationImpMockAddReactionExpectationOrigins
+	results            *ReactionMutationImpMockAddReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionMutationImpMockAddReactionParams contains parameters of the ReactionMutationImp.AddReaction
+type ReactionMutationImpMockAddReactionParams struct {
+	ctx    context.Context
+	key    model.ReactionKey
+	userID uuid.UUID
+	emoji  string
+}
+
+// ReactionMutationImpMockAddReactionParamPtrs contains pointers to parameters of the ReactionMutationImp.AddReaction
+type ReactionMutationImpMockAddReactionParamPtrs struct {
+	ctx    *context.Context
+	key    *model.ReactionKey
+	userID *uuid.UUID
+	emoji  *string
+}
+
+// ReactionMutationImpMockAddReactionResults contains results of the ReactionMutationImp.AddReaction
+type ReactionMutationImpMockAddReactionResults struct {
+	i1  int64
+	b1  bool
+	err error
+}
+
+// ReactionMutationImpMockAddReactionOrigins contains origins of expectations of the ReactionMutationImp.AddReaction
+type ReactionMutationImpMockAddReactionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originUserID string
+	originEmoji  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Optional() *mReactionMutationImpMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
+}
+
+// Expect sets up expected params for ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Expect(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &ReactionMutationImpMockAddReactionParams{ctx, key, userID, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) ExpectCtxParam1(ctx context.Context) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectKeyParam2 sets up expected param key for ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) ExpectKeyParam2(key model.ReactionKey) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.key = &key
+	mmAddReaction.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) ExpectUserIDParam3(userID uuid.UUID) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.userID = &userID
+	mmAddReaction.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) ExpectEmojiParam4(emoji string) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Inspect(f func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string)) *mReactionMutationImpMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ReactionMutationImpMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by ReactionMutationImp.AddReaction
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Return(i1 int64, b1 bool, err error) *ReactionMutationImpMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ReactionMutationImpMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &ReactionMutationImpMockAddReactionResults{i1, b1, err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the ReactionMutationImp.AddReaction method
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Set(f func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error)) *ReactionMutationImpMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ReactionMutationImp.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ReactionMutationImp.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the ReactionMutationImp.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mReactionMutationImpMockAddReaction) When(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) *ReactionMutationImpMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ReactionMutationImpMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &ReactionMutationImpMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &ReactionMutationImpMockAddReactionParams{ctx, key, userID, emoji},
+		expectationOrigins: ReactionMutationImpMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionMutationImp.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ReactionMutationImpMockAddReactionExpectation) Then(i1 int64, b1 bool, err error) *ReactionMutationImpMock {
+	e.results = &ReactionMutationImpMockAddReactionResults{i1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionMutationImp.AddReaction should be invoked
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Times(n uint64) *mReactionMutationImpMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of ReactionMutationImpMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mReactionMutationImpMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements ReactionMutationImp
+func (mmAddReaction *ReactionMutationImpMock) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, key, userID, emoji)
+	}
+
+	mm_params := ReactionMutationImpMockAddReactionParams{ctx, key, userID, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionMutationImpMockAddReactionParams{ctx, key, userID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("ReactionMutationImpMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAddReaction.t.Errorf("ReactionMutationImpMock.AddReaction got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddReaction.t.Errorf("ReactionMutationImpMock.AddReaction got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("ReactionMutationImpMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("ReactionMutationImpMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the ReactionMutationImpMock.AddReaction")
+		}
+		return (*mm_results).i1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, key, userID, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to ReactionMutationImpMock.AddReaction. %v %v %v %v", ctx, key, userID, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished ReactionMutationImpMock.AddReaction invocations
+func (mmAddReaction *ReactionMutationImpMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of ReactionMutationImpMock.AddReaction invocations
+func (mmAddReaction *ReactionMutationImpMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionMutationImpMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mReactionMutationImpMockAddReaction) Calls() []*ReactionMutationImpMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*ReactionMutationImpMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *ReactionMutationImpMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ReactionMutationImpMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to ReactionMutationImpMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionMutationImpMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mReactionMutationImpMockRemoveReaction struct {
+	optional           bool
+	mock               *ReactionMutationImpMock
+	defaultExpectation *ReactionMutationImpMockRemoveReactionExpectation
+	expectations       []*ReactionMutationImpMockRemoveReactionExpectation
+
+	callArgs []*ReactionMutationImpMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionMutationImpMockRemoveReactionExpectation specifies expectation struct of the ReactionMutationImp.RemoveReaction
+type ReactionMutationImpMockRemoveReactionExpectation struct {
+	mock               *ReactionMutationImpMock
+	params             *ReactionMutationImpMockRemoveReactionParams
+	paramPtrs          *ReactionMutationImpMockRemoveReactionParamPtrs
+	expectationOrigins ReactionMutationImpMockRemoveReactionExpectationOrigins
+	results            *ReactionMutationImpMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionMutationImpMockRemoveReactionParams contains parameters of the ReactionMutationImp.RemoveReaction
+type ReactionMutationImpMockRemoveReactionParams struct {
+	ctx    context.Context
+	key    model.ReactionKey
+	userID uuid.UUID
+	emoji  string
+}
+
+// ReactionMutationImpMockRemoveReactionParamPtrs contains pointers to parameters of the ReactionMutationImp.RemoveReaction
+type ReactionMutationImpMockRemoveReactionParamPtrs struct {
+	ctx    *context.Context
+	key    *model.ReactionKey
+	userID *uuid.UUID
+	emoji  *string
+}
+
+// ReactionMutationImpMockRemoveReactionResults contains results of the ReactionMutationImp.RemoveReaction
+type ReactionMutationImpMockRemoveReactionResults struct {
+	i1  int64
+	b1  bool
+	err error
+}
+
+// ReactionMutationImpMockRemoveReactionOrigins contains origins of expectations of the ReactionMutationImp.RemoveReaction
+type ReactionMutationImpMockRemoveReactionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originUserID string
+	originEmoji  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Optional() *mReactionMutationImpMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Expect(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ReactionMutationImpMockRemoveReactionParams{ctx, key, userID, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectKeyParam2 sets up expected param key for ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) ExpectKeyParam2(key model.ReactionKey) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.key = &key
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) ExpectUserIDParam3(userID uuid.UUID) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) ExpectEmojiParam4(emoji string) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ReactionMutationImpMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Inspect(f func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string)) *mReactionMutationImpMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ReactionMutationImpMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ReactionMutationImp.RemoveReaction
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Return(i1 int64, b1 bool, err error) *ReactionMutationImpMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ReactionMutationImpMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ReactionMutationImpMockRemoveReactionResults{i1, b1, err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ReactionMutationImp.RemoveReaction method
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Set(f func(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error)) *ReactionMutationImpMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ReactionMutationImp.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ReactionMutationImp.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ReactionMutationImp.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) When(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) *ReactionMutationImpMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ReactionMutationImpMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ReactionMutationImpMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &ReactionMutationImpMockRemoveReactionParams{ctx, key, userID, emoji},
+		expectationOrigins: ReactionMutationImpMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionMutationImp.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ReactionMutationImpMockRemoveReactionExpectation) Then(i1 int64, b1 bool, err error) *ReactionMutationImpMock {
+	e.results = &ReactionMutationImpMockRemoveReactionResults{i1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionMutationImp.RemoveReaction should be invoked
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Times(n uint64) *mReactionMutationImpMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ReactionMutationImpMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements ReactionMutationImp
+func (mmRemoveReaction *ReactionMutationImpMock) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (i1 int64, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, key, userID, emoji)
+	}
+
+	mm_params := ReactionMutationImpMockRemoveReactionParams{ctx, key, userID, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionMutationImpMockRemoveReactionParams{ctx, key, userID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ReactionMutationImpMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRemoveReaction.t.Errorf("ReactionMutationImpMock.RemoveReaction got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveReaction.t.Errorf("ReactionMutationImpMock.RemoveReaction got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("ReactionMutationImpMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ReactionMutationImpMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ReactionMutationImpMock.RemoveReaction")
+		}
+		return (*mm_results).i1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, key, userID, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ReactionMutationImpMock.RemoveReaction. %v %v %v %v", ctx, key, userID, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ReactionMutationImpMock.RemoveReaction invocations
+func (mmRemoveReaction *ReactionMutationImpMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ReactionMutationImpMock.RemoveReaction invocations
+func (mmRemoveReaction *ReactionMutationImpMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionMutationImpMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mReactionMutationImpMockRemoveReaction) Calls() []*ReactionMutationImpMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ReactionMutationImpMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ReactionMutationImpMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ReactionMutationImpMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionMutationImpMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to ReactionMutationImpMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionMutationImpMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReactionMutationImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddReactionInspect()
+
+			m.MinimockRemoveReactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReactionMutationImpMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReactionMutationImpMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockRemoveReactionDone()
+}
@@ -0,0 +1,13 @@
+package reactionquery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+//go:generate minimock -i ReactionQueryImp
+type ReactionQueryImp interface {
+	GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error)
+}
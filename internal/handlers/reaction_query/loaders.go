@@ -0,0 +1,53 @@
+package reactionquery
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/dataloader"
+)
+
+const (
+	loaderWait     = time.Millisecond
+	loaderMaxBatch = 100
+)
+
+type loadersKey struct{}
+
+type loaders struct {
+	reactions *dataloader.Loader[model.ReactionKey, []model.Reaction]
+}
+
+// WithLoaders returns the context with the loaders batching the reaction
+// lookups of one request.
+func (h *ReactionQuery) WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		reactions: dataloader.New(h.loadReactions, loaderWait, loaderMaxBatch),
+	})
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
+
+// loadReactions loads the reactions of the viewer's request, the viewer is the
+// same for the whole request, so it is taken from the context of the batch.
+func (h *ReactionQuery) loadReactions(ctx context.Context, keys []model.ReactionKey) ([][]model.Reaction, []error) {
+	values := make([][]model.Reaction, len(keys))
+
+	reactions, err := h.reactionQueryImp.GetReactions(ctx, keys, viewerID(ctx))
+	if err != nil {
+		loadErrs := make([]error, len(keys))
+		for i := range loadErrs {
+			loadErrs[i] = err
+		}
+		return values, loadErrs
+	}
+
+	for i, key := range keys {
+		values[i] = reactions[key]
+	}
+	return values, nil
+}
@@ -0,0 +1,51 @@
+package reactionquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+	"github.com/rs/zerolog/log"
+)
+
+type ReactionQuery struct {
+	reactionQueryImp ReactionQueryImp
+}
+
+func NewReactionQuery(reactionQueryImp ReactionQueryImp) *ReactionQuery {
+	return &ReactionQuery{reactionQueryImp: reactionQueryImp}
+}
+
+// GetReactions returns the reactions to the post or the comment, ReactedByMe
+// is false for every reaction when the request is anonymous.
+func (h *ReactionQuery) GetReactions(ctx context.Context, key model.ReactionKey) ([]model.Reaction, error) {
+	op := "internal.handlers.reactionquery.GetReactions()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var (
+		reactions []model.Reaction
+		err       error
+	)
+	if l := loadersFromContext(ctx); l != nil {
+		reactions, err = l.reactions.Load(ctx, key)
+	} else {
+		var byKey map[model.ReactionKey][]model.Reaction
+		byKey, err = h.reactionQueryImp.GetReactions(ctx, []model.ReactionKey{key}, viewerID(ctx))
+		reactions = byKey[key]
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return reactions, nil
+}
+
+// viewerID returns the user of the request, uuid.Nil for anonymous requests.
+func viewerID(ctx context.Context) uuid.UUID {
+	userID, _ := viewer.FromContext(ctx)
+	return userID
+}
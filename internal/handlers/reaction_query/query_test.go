@@ -0,0 +1,87 @@
+package reactionquery
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/viewer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReactionQuery(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	reactionQueryImpMock := NewReactionQueryImpMock(mc)
+	handler := ReactionQuery{reactionQueryImp: reactionQueryImpMock}
+
+	postKey := model.ReactionKey{Target: model.ReactionTargetPost, ID: 1}
+
+	t.Run("Anonymous request reacted to nothing", func(t *testing.T) {
+		ctx := context.Background()
+		reactions := []model.Reaction{{Emoji: "👍", Count: 2}}
+
+		reactionQueryImpMock.GetReactionsMock.Expect(ctx, []model.ReactionKey{postKey}, uuid.Nil).
+			Return(map[model.ReactionKey][]model.Reaction{postKey: reactions}, nil)
+		got, err := handler.GetReactions(ctx, postKey)
+		assert.NoError(t, err)
+		assert.Equal(t, reactions, got)
+	})
+
+}
+
+func TestReactionQueryLoaders(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	reactionQueryImpMock := NewReactionQueryImpMock(mc)
+	handler := ReactionQuery{reactionQueryImp: reactionQueryImpMock}
+
+	postKey := model.ReactionKey{Target: model.ReactionTargetPost, ID: 1}
+	commentKey := model.ReactionKey{Target: model.ReactionTargetComment, ID: 1}
+
+	t.Run("Reactions are loaded in one batch for the viewer", func(t *testing.T) {
+		userID := uuid.New()
+		ctx := handler.WithLoaders(viewer.NewContext(context.Background(), userID))
+		reactions := []model.Reaction{{Emoji: "🔥", Count: 1, ReactedByMe: true}}
+
+		reactionQueryImpMock.GetReactionsMock.Set(func(ctx context.Context, keys []model.ReactionKey, viewerID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+			assert.ElementsMatch(t, []model.ReactionKey{postKey, commentKey}, keys)
+			assert.Equal(t, userID, viewerID)
+			return map[model.ReactionKey][]model.Reaction{commentKey: reactions}, nil
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			got, err := handler.GetReactions(ctx, postKey)
+			assert.NoError(t, err)
+			assert.Empty(t, got)
+		}()
+		go func() {
+			defer wg.Done()
+			got, err := handler.GetReactions(ctx, commentKey)
+			assert.NoError(t, err)
+			assert.Equal(t, reactions, got)
+		}()
+		wg.Wait()
+
+		assert.Equal(t, uint64(1), reactionQueryImpMock.GetReactionsAfterCounter())
+	})
+
+	t.Run("Storage error is returned", func(t *testing.T) {
+		ctx := handler.WithLoaders(context.Background())
+
+		reactionQueryImpMock.GetReactionsMock.Set(func(ctx context.Context, keys []model.ReactionKey, viewerID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+			return nil, errors.New("unexpected error")
+		})
+
+		got, err := handler.GetReactions(ctx, postKey)
+		assert.NotNil(t, err)
+		assert.Nil(t, got)
+	})
+}
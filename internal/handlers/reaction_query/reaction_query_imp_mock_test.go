@@ -0,0 +1,450 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package reactionquery
+
+//go:generate minimock -i github.com/nabishec/ozon_habr_api/internal/handlers/reaction_query.ReactionQueryImp -o reaction_query_imp_mock_test.go -n ReactionQueryImpMock -p reactionquery
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+// ReactionQueryImpMock implements ReactionQueryImp
+type ReactionQueryImpMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetReactions          func(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (m1 map[model.ReactionKey][]model.Reaction, err error)
+	funcGetReactionsOrigin    string
+	inspectFuncGetReactions   func(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID)
+	afterGetReactionsCounter  uint64
+	beforeGetReactionsCounter uint64
+	GetReactionsMock          mReactionQueryImpMockGetReactions
+}
+
+// NewReactionQueryImpMock returns a mock for ReactionQueryImp
+func NewReactionQueryImpMock(t minimock.Tester) *ReactionQueryImpMock {
+	m := &ReactionQueryImpMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetReactionsMock = mReactionQueryImpMockGetReactions{mock: m}
+	m.GetReactionsMock.callArgs = []*ReactionQueryImpMockGetReactionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReactionQueryImpMockGetReactions struct {
+	optional           bool
+	mock               *ReactionQueryImpMock
+	defaultExpectation *ReactionQueryImpMockGetReactionsExpectation
+	expectations       []*ReactionQueryImpMockGetReactionsExpectation
+
+	callArgs []*ReactionQueryImpMockGetReactionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReactionQueryImpMockGetReactionsExpectation specifies expectation struct of the ReactionQueryImp.GetReactions
+type ReactionQueryImpMockGetReactionsExpectation struct {
+	mock               *ReactionQueryImpMock
+	params             *ReactionQueryImpMockGetReactionsParams
+	paramPtrs          *ReactionQueryImpMockGetReactionsParamPtrs
+	expectationOrigins ReactionQueryImpMockGetReactionsExpectationOrigins
+	results            *ReactionQueryImpMockGetReactionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReactionQueryImpMockGetReactionsParams contains parameters of the ReactionQueryImp.GetReactions
+type ReactionQueryImpMockGetReactionsParams struct {
+	ctx    context.Context
+	keys   []model.ReactionKey
+	userID uuid.UUID
+}
+
+// ReactionQueryImpMockGetReactionsParamPtrs contains pointers to parameters of the ReactionQueryImp.GetReactions
+type ReactionQueryImpMockGetReactionsParamPtrs struct {
+	ctx    *context.Context
+	keys   *[]model.ReactionKey
+	userID *uuid.UUID
+}
+
+// ReactionQueryImpMockGetReactionsResults contains results of the ReactionQueryImp.GetReactions
+type ReactionQueryImpMockGetReactionsResults struct {
+	m1  map[model.ReactionKey][]model.Reaction
+	err error
+}
+
+// ReactionQueryImpMockGetReactionsOrigins contains origins of expectations of the ReactionQueryImp.GetReactions
+type ReactionQueryImpMockGetReactionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKeys   string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Optional() *mReactionQueryImpMockGetReactions {
+	mmGetReactions.optional = true
+	return mmGetReactions
+}
+
+// Expect sets up expected params for ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Expect(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) *mReactionQueryImpMockGetReactions {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	if mmGetReactions.defaultExpectation == nil {
+		mmGetReactions.defaultExpectation = &ReactionQueryImpMockGetReactionsExpectation{}
+	}
+
+	if mmGetReactions.defaultExpectation.paramPtrs != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by ExpectParams functions")
+	}
+
+	mmGetReactions.defaultExpectation.params = &ReactionQueryImpMockGetReactionsParams{ctx, keys, userID}
+	mmGetReactions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReactions.expectations {
+		if minimock.Equal(e.params, mmGetReactions.defaultExpectation.params) {
+			mmGetReactions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReactions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReactions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) ExpectCtxParam1(ctx context.Context) *mReactionQueryImpMockGetReactions {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	if mmGetReactions.defaultExpectation == nil {
+		mmGetReactions.defaultExpectation = &ReactionQueryImpMockGetReactionsExpectation{}
+	}
+
+	if mmGetReactions.defaultExpectation.params != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Expect")
+	}
+
+	if mmGetReactions.defaultExpectation.paramPtrs == nil {
+		mmGetReactions.defaultExpectation.paramPtrs = &ReactionQueryImpMockGetReactionsParamPtrs{}
+	}
+	mmGetReactions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReactions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReactions
+}
+
+// ExpectKeysParam2 sets up expected param keys for ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) ExpectKeysParam2(keys []model.ReactionKey) *mReactionQueryImpMockGetReactions {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	if mmGetReactions.defaultExpectation == nil {
+		mmGetReactions.defaultExpectation = &ReactionQueryImpMockGetReactionsExpectation{}
+	}
+
+	if mmGetReactions.defaultExpectation.params != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Expect")
+	}
+
+	if mmGetReactions.defaultExpectation.paramPtrs == nil {
+		mmGetReactions.defaultExpectation.paramPtrs = &ReactionQueryImpMockGetReactionsParamPtrs{}
+	}
+	mmGetReactions.defaultExpectation.paramPtrs.keys = &keys
+	mmGetReactions.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+
+	return mmGetReactions
+}
+
+// ExpectUserIDParam3 sets up expected param userID for ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) ExpectUserIDParam3(userID uuid.UUID) *mReactionQueryImpMockGetReactions {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	if mmGetReactions.defaultExpectation == nil {
+		mmGetReactions.defaultExpectation = &ReactionQueryImpMockGetReactionsExpectation{}
+	}
+
+	if mmGetReactions.defaultExpectation.params != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Expect")
+	}
+
+	if mmGetReactions.defaultExpectation.paramPtrs == nil {
+		mmGetReactions.defaultExpectation.paramPtrs = &ReactionQueryImpMockGetReactionsParamPtrs{}
+	}
+	mmGetReactions.defaultExpectation.paramPtrs.userID = &userID
+	mmGetReactions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetReactions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Inspect(f func(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID)) *mReactionQueryImpMockGetReactions {
+	if mmGetReactions.mock.inspectFuncGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("Inspect function is already set for ReactionQueryImpMock.GetReactions")
+	}
+
+	mmGetReactions.mock.inspectFuncGetReactions = f
+
+	return mmGetReactions
+}
+
+// Return sets up results that will be returned by ReactionQueryImp.GetReactions
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Return(m1 map[model.ReactionKey][]model.Reaction, err error) *ReactionQueryImpMock {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	if mmGetReactions.defaultExpectation == nil {
+		mmGetReactions.defaultExpectation = &ReactionQueryImpMockGetReactionsExpectation{mock: mmGetReactions.mock}
+	}
+	mmGetReactions.defaultExpectation.results = &ReactionQueryImpMockGetReactionsResults{m1, err}
+	mmGetReactions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReactions.mock
+}
+
+// Set uses given function f to mock the ReactionQueryImp.GetReactions method
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Set(f func(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (m1 map[model.ReactionKey][]model.Reaction, err error)) *ReactionQueryImpMock {
+	if mmGetReactions.defaultExpectation != nil {
+		mmGetReactions.mock.t.Fatalf("Default expectation is already set for the ReactionQueryImp.GetReactions method")
+	}
+
+	if len(mmGetReactions.expectations) > 0 {
+		mmGetReactions.mock.t.Fatalf("Some expectations are already set for the ReactionQueryImp.GetReactions method")
+	}
+
+	mmGetReactions.mock.funcGetReactions = f
+	mmGetReactions.mock.funcGetReactionsOrigin = minimock.CallerInfo(1)
+	return mmGetReactions.mock
+}
+
+// When sets expectation for the ReactionQueryImp.GetReactions which will trigger the result defined by the following
+// Then helper
+func (mmGetReactions *mReactionQueryImpMockGetReactions) When(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) *ReactionQueryImpMockGetReactionsExpectation {
+	if mmGetReactions.mock.funcGetReactions != nil {
+		mmGetReactions.mock.t.Fatalf("ReactionQueryImpMock.GetReactions mock is already set by Set")
+	}
+
+	expectation := &ReactionQueryImpMockGetReactionsExpectation{
+		mock:               mmGetReactions.mock,
+		params:             &ReactionQueryImpMockGetReactionsParams{ctx, keys, userID},
+		expectationOrigins: ReactionQueryImpMockGetReactionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReactions.expectations = append(mmGetReactions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReactionQueryImp.GetReactions return parameters for the expectation previously defined by the When method
+func (e *ReactionQueryImpMockGetReactionsExpectation) Then(m1 map[model.ReactionKey][]model.Reaction, err error) *ReactionQueryImpMock {
+	e.results = &ReactionQueryImpMockGetReactionsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times ReactionQueryImp.GetReactions should be invoked
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Times(n uint64) *mReactionQueryImpMockGetReactions {
+	if n == 0 {
+		mmGetReactions.mock.t.Fatalf("Times of ReactionQueryImpMock.GetReactions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReactions.expectedInvocations, n)
+	mmGetReactions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReactions
+}
+
+func (mmGetReactions *mReactionQueryImpMockGetReactions) invocationsDone() bool {
+	if len(mmGetReactions.expectations) == 0 && mmGetReactions.defaultExpectation == nil && mmGetReactions.mock.funcGetReactions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReactions.mock.afterGetReactionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReactions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReactions implements ReactionQueryImp
+func (mmGetReactions *ReactionQueryImpMock) GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (m1 map[model.ReactionKey][]model.Reaction, err error) {
+	mm_atomic.AddUint64(&mmGetReactions.beforeGetReactionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReactions.afterGetReactionsCounter, 1)
+
+	mmGetReactions.t.Helper()
+
+	if mmGetReactions.inspectFuncGetReactions != nil {
+		mmGetReactions.inspectFuncGetReactions(ctx, keys, userID)
+	}
+
+	mm_params := ReactionQueryImpMockGetReactionsParams{ctx, keys, userID}
+
+	// Record call args
+	mmGetReactions.GetReactionsMock.mutex.Lock()
+	mmGetReactions.GetReactionsMock.callArgs = append(mmGetReactions.GetReactionsMock.callArgs, &mm_params)
+	mmGetReactions.GetReactionsMock.mutex.Unlock()
+
+	for _, e := range mmGetReactions.GetReactionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetReactions.GetReactionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReactions.GetReactionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReactions.GetReactionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReactions.GetReactionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ReactionQueryImpMockGetReactionsParams{ctx, keys, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReactions.t.Errorf("ReactionQueryImpMock.GetReactions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReactions.GetReactionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmGetReactions.t.Errorf("ReactionQueryImpMock.GetReactions got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReactions.GetReactionsMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetReactions.t.Errorf("ReactionQueryImpMock.GetReactions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReactions.GetReactionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReactions.t.Errorf("ReactionQueryImpMock.GetReactions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReactions.GetReactionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReactions.GetReactionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReactions.t.Fatal("No results are set for the ReactionQueryImpMock.GetReactions")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetReactions.funcGetReactions != nil {
+		return mmGetReactions.funcGetReactions(ctx, keys, userID)
+	}
+	mmGetReactions.t.Fatalf("Unexpected call to ReactionQueryImpMock.GetReactions. %v %v %v", ctx, keys, userID)
+	return
+}
+
+// GetReactionsAfterCounter returns a count of finished ReactionQueryImpMock.GetReactions invocations
+func (mmGetReactions *ReactionQueryImpMock) GetReactionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReactions.afterGetReactionsCounter)
+}
+
+// GetReactionsBeforeCounter returns a count of ReactionQueryImpMock.GetReactions invocations
+func (mmGetReactions *ReactionQueryImpMock) GetReactionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReactions.beforeGetReactionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReactionQueryImpMock.GetReactions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReactions *mReactionQueryImpMockGetReactions) Calls() []*ReactionQueryImpMockGetReactionsParams {
+	mmGetReactions.mutex.RLock()
+
+	argCopy := make([]*ReactionQueryImpMockGetReactionsParams, len(mmGetReactions.callArgs))
+	copy(argCopy, mmGetReactions.callArgs)
+
+	mmGetReactions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReactionsDone returns true if the count of the GetReactions invocations corresponds
+// the number of defined expectations
+func (m *ReactionQueryImpMock) MinimockGetReactionsDone() bool {
+	if m.GetReactionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReactionsMock.invocationsDone()
+}
+
+// MinimockGetReactionsInspect logs each unmet expectation
+func (m *ReactionQueryImpMock) MinimockGetReactionsInspect() {
+	for _, e := range m.GetReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReactionQueryImpMock.GetReactions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReactionsCounter := mm_atomic.LoadUint64(&m.afterGetReactionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReactionsMock.defaultExpectation != nil && afterGetReactionsCounter < 1 {
+		if m.GetReactionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReactionQueryImpMock.GetReactions at\n%s", m.GetReactionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReactionQueryImpMock.GetReactions at\n%s with params: %#v", m.GetReactionsMock.defaultExpectation.expectationOrigins.origin, *m.GetReactionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReactions != nil && afterGetReactionsCounter < 1 {
+		m.t.Errorf("Expected call to ReactionQueryImpMock.GetReactions at\n%s", m.funcGetReactionsOrigin)
+	}
+
+	if !m.GetReactionsMock.invocationsDone() && afterGetReactionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReactionQueryImpMock.GetReactions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReactionsMock.expectedInvocations), m.GetReactionsMock.expectedInvocationsOrigin, afterGetReactionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReactionQueryImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetReactionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReactionQueryImpMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReactionQueryImpMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetReactionsDone()
+}
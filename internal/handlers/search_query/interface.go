@@ -0,0 +1,12 @@
+package searchquery
+
+import (
+	"context"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+//go:generate minimock -i SearchQueryImp
+type SearchQueryImp interface {
+	Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error)
+}
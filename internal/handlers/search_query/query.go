@@ -0,0 +1,42 @@
+package searchquery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/rs/zerolog/log"
+)
+
+// maxQueryLength bounds the search query in characters.
+const maxQueryLength = 256
+
+type SearchQuery struct {
+	searchQueryImp SearchQueryImp
+}
+
+func NewSearchQuery(searchQueryImp SearchQueryImp) *SearchQuery {
+	return &SearchQuery{searchQueryImp: searchQueryImp}
+}
+
+func (h *SearchQuery) Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	op := "internal.handlers.searchquery.Search()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	opts.Query = strings.TrimSpace(opts.Query)
+	if opts.Query == "" || !utf8.ValidString(opts.Query) || utf8.RuneCountInString(opts.Query) > maxQueryLength {
+		return nil, errs.ErrInvalidSearchQuery
+	}
+
+	hits, err := h.searchQueryImp.Search(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return hits, nil
+}
@@ -0,0 +1,51 @@
+package searchquery
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	searchQueryImpMock := NewSearchQueryImpMock(mc)
+	handler := SearchQuery{searchQueryImp: searchQueryImpMock}
+
+	t.Run("Successfully search with the trimmed query", func(t *testing.T) {
+		ctx := context.Background()
+		hits := []*model.SearchHit{{Post: &model.Post{ID: 1}, Rank: 1}}
+
+		searchQueryImpMock.SearchMock.Expect(ctx, model.SearchOptions{Query: "кошки cats", Type: model.SearchPosts, Limit: 6}).Return(hits, nil)
+		got, err := handler.Search(ctx, model.SearchOptions{Query: "  кошки cats\n", Type: model.SearchPosts, Limit: 6})
+		assert.NoError(t, err)
+		assert.Equal(t, hits, got)
+	})
+
+	t.Run("Error empty query", func(t *testing.T) {
+		hits, err := handler.Search(context.Background(), model.SearchOptions{Query: " \t", Type: model.SearchPosts})
+		assert.Equal(t, errs.ErrInvalidSearchQuery, err)
+		assert.Nil(t, hits)
+	})
+
+	t.Run("Error query too long", func(t *testing.T) {
+		hits, err := handler.Search(context.Background(), model.SearchOptions{Query: strings.Repeat("я", maxQueryLength+1), Type: model.SearchComments})
+		assert.Equal(t, errs.ErrInvalidSearchQuery, err)
+		assert.Nil(t, hits)
+	})
+
+	t.Run("Unexpected error from search", func(t *testing.T) {
+		ctx := context.Background()
+
+		searchQueryImpMock.SearchMock.Expect(ctx, model.SearchOptions{Query: "go", Type: model.SearchComments}).Return(nil, errors.New("unexpected error"))
+		hits, err := handler.Search(ctx, model.SearchOptions{Query: "go", Type: model.SearchComments})
+		assert.NotNil(t, err)
+		assert.Nil(t, hits)
+	})
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package searchquery
+
+//go:generate minimock -i github.com/nabishec/ozon_habr_api/internal/handlers/search_query.SearchQueryImp -o search_query_imp_mock_test.go -n SearchQueryImpMock -p searchquery
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+)
+
+// SearchQueryImpMock implements SearchQueryImp
+type SearchQueryImpMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSearch          func(ctx context.Context, opts model.SearchOptions) (spa1 []*model.SearchHit, err error)
+	funcSearchOrigin    string
+	inspectFuncSearch   func(ctx context.Context, opts model.SearchOptions)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mSearchQueryImpMockSearch
+}
+
+// NewSearchQueryImpMock returns a mock for SearchQueryImp
+func NewSearchQueryImpMock(t minimock.Tester) *SearchQueryImpMock {
+	m := &SearchQueryImpMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SearchMock = mSearchQueryImpMockSearch{mock: m}
+	m.SearchMock.callArgs = []*SearchQueryImpMockSearchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSearchQueryImpMockSearch struct {
+	optional           bool
+	mock               *SearchQueryImpMock
+	defaultExpectation *SearchQueryImpMockSearchExpectation
+	expectations       []*SearchQueryImpMockSearchExpectation
+
+	callArgs []*SearchQueryImpMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SearchQueryImpMockSearchExpectation specifies expectation struct of the SearchQueryImp.Search
+type SearchQueryImpMockSearchExpectation struct {
+	mock               *SearchQueryImpMock
+	params             *SearchQueryImpMockSearchParams
+	paramPtrs          *SearchQueryImpMockSearchParamPtrs
+	expectationOrigins SearchQueryImpMockSearchExpectationOrigins
+	results            *SearchQueryImpMockSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SearchQueryImpMockSearchParams contains parameters of the SearchQueryImp.Search
+type SearchQueryImpMockSearchParams struct {
+	ctx  context.Context
+	opts model.SearchOptions
+}
+
+// SearchQueryImpMockSearchParamPtrs contains pointers to parameters of the SearchQueryImp.Search
+type SearchQueryImpMockSearchParamPtrs struct {
+	ctx  *context.Context
+	opts *model.SearchOptions
+}
+
+// SearchQueryImpMockSearchResults contains results of the SearchQueryImp.Search
+type SearchQueryImpMockSearchResults struct {
+	spa1 []*model.SearchHit
+	err  error
+}
+
+// SearchQueryImpMockSearchOrigins contains origins of expectations of the SearchQueryImp.Search
+type SearchQueryImpMockSearchExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originOpts string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mSearchQueryImpMockSearch) Optional() *mSearchQueryImpMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for SearchQueryImp.Search
+func (mmSearch *mSearchQueryImpMockSearch) Expect(ctx context.Context, opts model.SearchOptions) *mSearchQueryImpMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchQueryImpMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &SearchQueryImpMockSearchParams{ctx, opts}
+	mmSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SearchQueryImp.Search
+func (mmSearch *mSearchQueryImpMockSearch) ExpectCtxParam1(ctx context.Context) *mSearchQueryImpMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchQueryImpMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchQueryImpMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectOptsParam2 sets up expected param opts for SearchQueryImp.Search
+func (mmSearch *mSearchQueryImpMockSearch) ExpectOptsParam2(opts model.SearchOptions) *mSearchQueryImpMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchQueryImpMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &SearchQueryImpMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.opts = &opts
+	mmSearch.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the SearchQueryImp.Search
+func (mmSearch *mSearchQueryImpMockSearch) Inspect(f func(ctx context.Context, opts model.SearchOptions)) *mSearchQueryImpMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for SearchQueryImpMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by SearchQueryImp.Search
+func (mmSearch *mSearchQueryImpMockSearch) Return(spa1 []*model.SearchHit, err error) *SearchQueryImpMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &SearchQueryImpMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &SearchQueryImpMockSearchResults{spa1, err}
+	mmSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the SearchQueryImp.Search method
+func (mmSearch *mSearchQueryImpMockSearch) Set(f func(ctx context.Context, opts model.SearchOptions) (spa1 []*model.SearchHit, err error)) *SearchQueryImpMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the SearchQueryImp.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the SearchQueryImp.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	mmSearch.mock.funcSearchOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// When sets expectation for the SearchQueryImp.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mSearchQueryImpMockSearch) When(ctx context.Context, opts model.SearchOptions) *SearchQueryImpMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("SearchQueryImpMock.Search mock is already set by Set")
+	}
+
+	expectation := &SearchQueryImpMockSearchExpectation{
+		mock:               mmSearch.mock,
+		params:             &SearchQueryImpMockSearchParams{ctx, opts},
+		expectationOrigins: SearchQueryImpMockSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SearchQueryImp.Search return parameters for the expectation previously defined by the When method
+func (e *SearchQueryImpMockSearchExpectation) Then(spa1 []*model.SearchHit, err error) *SearchQueryImpMock {
+	e.results = &SearchQueryImpMockSearchResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times SearchQueryImp.Search should be invoked
+func (mmSearch *mSearchQueryImpMockSearch) Times(n uint64) *mSearchQueryImpMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of SearchQueryImpMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	mmSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearch
+}
+
+func (mmSearch *mSearchQueryImpMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Search implements SearchQueryImp
+func (mmSearch *SearchQueryImpMock) Search(ctx context.Context, opts model.SearchOptions) (spa1 []*model.SearchHit, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
+
+	mmSearch.t.Helper()
+
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, opts)
+	}
+
+	mm_params := SearchQueryImpMockSearchParams{ctx, opts}
+
+	// Record call args
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
+
+	for _, e := range mmSearch.SearchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
+
+		mm_got := SearchQueryImpMockSearchParams{ctx, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearch.t.Errorf("SearchQueryImpMock.Search got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmSearch.t.Errorf("SearchQueryImpMock.Search got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearch.t.Errorf("SearchQueryImpMock.Search got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearch.SearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearch.t.Fatal("No results are set for the SearchQueryImpMock.Search")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, opts)
+	}
+	mmSearch.t.Fatalf("Unexpected call to SearchQueryImpMock.Search. %v %v", ctx, opts)
+	return
+}
+
+// SearchAfterCounter returns a count of finished SearchQueryImpMock.Search invocations
+func (mmSearch *SearchQueryImpMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
+}
+
+// SearchBeforeCounter returns a count of SearchQueryImpMock.Search invocations
+func (mmSearch *SearchQueryImpMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
+}
+
+// Calls returns a list of arguments used in each call to SearchQueryImpMock.Search.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearch *mSearchQueryImpMockSearch) Calls() []*SearchQueryImpMockSearchParams {
+	mmSearch.mutex.RLock()
+
+	argCopy := make([]*SearchQueryImpMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
+
+	mmSearch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
+// the number of defined expectations
+func (m *SearchQueryImpMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMock.invocationsDone()
+}
+
+// MinimockSearchInspect logs each unmet expectation
+func (m *SearchQueryImpMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SearchQueryImpMock.Search at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SearchQueryImpMock.Search at\n%s", m.SearchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SearchQueryImpMock.Search at\n%s with params: %#v", m.SearchMock.defaultExpectation.expectationOrigins.origin, *m.SearchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Errorf("Expected call to SearchQueryImpMock.Search at\n%s", m.funcSearchOrigin)
+	}
+
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to SearchQueryImpMock.Search at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), m.SearchMock.expectedInvocationsOrigin, afterSearchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SearchQueryImpMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSearchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SearchQueryImpMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SearchQueryImpMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSearchDone()
+}
@@ -0,0 +1,112 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusFail     = "fail"
+)
+
+// Check is a single readiness probe. A failed non critical check only makes
+// the service degraded, like the cache that the storage can work without.
+type Check struct {
+	Name     string
+	Critical bool
+	Run      func(ctx context.Context) (any, error)
+}
+
+type CheckResult struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Details  any    `json:"details,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks"`
+}
+
+// LivenessHandler reports that the process is alive and serving requests.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, &Report{Status: StatusOK, Checks: map[string]*CheckResult{}})
+	})
+}
+
+// ReadinessHandler runs every check concurrently, each limited by timeout. It
+// responds 503 only when a critical check fails.
+func ReadinessHandler(checks []Check, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := Run(r.Context(), checks, timeout)
+
+		code := http.StatusOK
+		if report.Status == StatusFail {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	})
+}
+
+func Run(ctx context.Context, checks []Check, timeout time.Duration) *Report {
+	report := &Report{
+		Status: StatusOK,
+		Checks: make(map[string]*CheckResult, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, check := range checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			details, err := check.Run(checkCtx)
+			result := &CheckResult{
+				Status:   StatusOK,
+				Duration: time.Since(start).String(),
+				Details:  details,
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Status = StatusFail
+				result.Error = err.Error()
+				if check.Critical {
+					report.Status = StatusFail
+				} else if report.Status == StatusOK {
+					report.Status = StatusDegraded
+				}
+			}
+			report.Checks[check.Name] = result
+		}(check)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func writeJSON(w http.ResponseWriter, code int, report *Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	err := json.NewEncoder(w).Encode(report)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed write health report")
+	}
+}
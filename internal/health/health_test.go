@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness(t *testing.T) {
+	ok := func(ctx context.Context) (any, error) { return nil, nil }
+	fail := func(ctx context.Context) (any, error) { return nil, errors.New("connection refused") }
+	slow := func(ctx context.Context) (any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	t.Run("All checks pass", func(t *testing.T) {
+		report := Run(context.Background(), []Check{
+			{Name: "postgres", Critical: true, Run: ok},
+			{Name: "redis", Run: ok},
+		}, time.Second)
+		assert.Equal(t, StatusOK, report.Status)
+		assert.Len(t, report.Checks, 2)
+	})
+
+	t.Run("Cache down is degraded", func(t *testing.T) {
+		report := Run(context.Background(), []Check{
+			{Name: "postgres", Critical: true, Run: ok},
+			{Name: "redis", Run: fail},
+		}, time.Second)
+		assert.Equal(t, StatusDegraded, report.Status)
+		assert.Equal(t, "connection refused", report.Checks["redis"].Error)
+	})
+
+	t.Run("Critical check timeout fails", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		ReadinessHandler([]Check{
+			{Name: "postgres", Critical: true, Run: slow},
+			{Name: "redis", Run: fail},
+		}, 10*time.Millisecond).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"fail"`)
+	})
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/operation"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// GraphQL is a gqlgen extension that measures latency and errors of every operation.
+// The operations are labeled with their root field, the names sent by the
+// clients would let any client create new series.
+type GraphQL struct {
+	metrics *Metrics
+	schema  *ast.Schema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = (*GraphQL)(nil)
+
+func (m *Metrics) GraphQL() *GraphQL {
+	return &GraphQL{metrics: m}
+}
+
+func (*GraphQL) ExtensionName() string {
+	return "Metrics"
+}
+
+func (e *GraphQL) Validate(schema graphql.ExecutableSchema) error {
+	e.schema = schema.Schema()
+	return nil
+}
+
+func (e *GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+
+	name, opType := operation.RootField(ctx, e.schema)
+	var start time.Time
+	if graphql.HasOperationContext(ctx) {
+		start = graphql.GetOperationContext(ctx).Stats.OperationStart
+	}
+
+	if resp != nil && len(resp.Errors) > 0 {
+		e.metrics.operationErrors.WithLabelValues(name, opType).Add(float64(len(resp.Errors)))
+	}
+
+	// every subscription event passes here, its latency isn't meaningful
+	if opType != string(ast.Subscription) && !start.IsZero() {
+		e.metrics.operationDuration.WithLabelValues(name, opType).Observe(time.Since(start).Seconds())
+	}
+
+	return resp
+}
@@ -0,0 +1,144 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+var testSchema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query { posts: [Int!]!, post(id: ID!): Int }
+type Mutation { addPost(title: String!): Int! }
+type Subscription { postAdded: Int! }
+`})
+
+// fakeSchema is the executable schema the extension is validated with, only
+// its schema is used.
+type fakeSchema struct {
+	graphql.ExecutableSchema
+}
+
+func (fakeSchema) Schema() *ast.Schema {
+	return testSchema
+}
+
+// operationContext returns the context of the operation gqlgen builds for the
+// query. The query is only parsed, so it may have fields missing from the
+// schema.
+func operationContext(t *testing.T, operationName, query string) context.Context {
+	t.Helper()
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	require.NoError(t, err)
+
+	return graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		RawQuery:      query,
+		OperationName: operationName,
+		Doc:           doc,
+		Operation:     doc.Operations.ForName(operationName),
+		Stats:         graphql.Stats{OperationStart: time.Now()},
+	})
+}
+
+func newGraphQL(t *testing.T) (*Metrics, *GraphQL) {
+	t.Helper()
+
+	m := New()
+	extension := m.GraphQL()
+	require.NoError(t, extension.Validate(fakeSchema{}))
+	return m, extension
+}
+
+func TestGraphQLOperationLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		operationName string
+		query         string
+		operation     string
+		opType        string
+	}{
+		{"Client name is not a label", "RandomName123", "query RandomName123 { posts }", "posts", "query"},
+		{"Anonymous operation", "", "{ post(id: 1) }", "post", "query"},
+		{"Aliases of one field", "", "{ a: post(id: 1) b: post(id: 2) }", "post", "query"},
+		{"Several root fields", "", "{ posts post(id: 1) }", "multiple", "query"},
+		{"Introspection", "", "{ __typename }", "__typename", "query"},
+		{"Mutation", "AddPost", "mutation AddPost { addPost(title: \"t\") }", "addPost", "mutation"},
+		{"Field missing from the schema", "", "{ random123 }", "unknown", "query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, extension := newGraphQL(t)
+			ctx := operationContext(t, tt.operationName, tt.query)
+
+			extension.InterceptResponse(ctx, func(context.Context) *graphql.Response {
+				return &graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("failed")}}
+			})
+
+			assert.Equal(t, 1, testutil.CollectAndCount(m.operationErrors))
+			assert.Equal(t, 1.0, testutil.ToFloat64(m.operationErrors.WithLabelValues(tt.operation, tt.opType)))
+			assert.Equal(t, 1, testutil.CollectAndCount(m.operationDuration))
+			assert.EqualValues(t, 1, histogramCount(t, m, "ozon_habr_graphql_operation_duration_seconds", map[string]string{"operation": tt.operation, "type": tt.opType}))
+		})
+	}
+}
+
+func TestGraphQLRejectedRequest(t *testing.T) {
+	m, extension := newGraphQL(t)
+
+	// a request rejected before parsing has no operation context
+	extension.InterceptResponse(context.Background(), func(context.Context) *graphql.Response {
+		return graphql.ErrorResponse(context.Background(), "%s", errors.New("invalid body"))
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.operationErrors.WithLabelValues("unknown", "unknown")))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.operationDuration), "there is no start time to measure from")
+}
+
+func TestGraphQLSubscription(t *testing.T) {
+	m, extension := newGraphQL(t)
+	ctx := operationContext(t, "", "subscription { postAdded }")
+
+	for range 3 {
+		extension.InterceptResponse(ctx, func(context.Context) *graphql.Response {
+			return &graphql.Response{}
+		})
+	}
+
+	assert.Equal(t, 0, testutil.CollectAndCount(m.operationDuration), "the events of a subscription aren't operations")
+	assert.Equal(t, 0, testutil.CollectAndCount(m.operationErrors))
+}
+
+// histogramCount returns the number of observations of the histogram series
+// with the labels.
+func histogramCount(t *testing.T, m *Metrics, name string, labels map[string]string) uint64 {
+	t.Helper()
+
+	families, err := m.registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+			return metric.GetHistogram().GetSampleCount()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "ozon_habr"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	operationDuration *prometheus.HistogramVec
+	operationErrors   *prometheus.CounterVec
+	storageDuration   *prometheus.HistogramVec
+	cacheRequests     *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		operationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of GraphQL queries and mutations by root field.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "type"}),
+		operationErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "operation_errors_total",
+			Help:      "Number of errors returned by GraphQL operations by root field.",
+		}, []string{"operation", "type"}),
+		storageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "method_duration_seconds",
+			Help:      "Duration of storage method calls.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"method", "status"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "requests_total",
+			Help:      "Results of comment branch lookups in the redis cache.",
+		}, []string{"result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.operationDuration,
+		m.operationErrors,
+		m.storageDuration,
+		m.cacheRequests,
+	)
+
+	// the results reported by db.Storage are exported as zero before the first lookup
+	for _, result := range []string{"hit", "miss", "error"} {
+		m.cacheRequests.WithLabelValues(result)
+	}
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveCache counts the result of a cache lookup.
+func (m *Metrics) ObserveCache(result string) {
+	m.cacheRequests.WithLabelValues(result).Inc()
+}
+
+// RegisterDBStats exports the sql pool statistics.
+func (m *Metrics) RegisterDBStats(db *sql.DB, dbName string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// SubscriptionsSource is implemented by the subscription broker.
+type SubscriptionsSource interface {
+	Active() int
+	Dropped() uint64
+}
+
+// RegisterSubscriptions exports the statistics of the subscription broker,
+// labeled with the name of the subscription field.
+func (m *Metrics) RegisterSubscriptions(subscription string, source SubscriptionsSource) {
+	labels := prometheus.Labels{"subscription": subscription}
+	m.registry.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "subscriptions",
+			Name:        "active",
+			Help:        "Number of active subscriptions.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(source.Active()) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "subscriptions",
+			Name:        "dropped_events_total",
+			Help:        "Number of events dropped because a subscriber's buffer was full.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(source.Dropped()) }),
+	)
+}
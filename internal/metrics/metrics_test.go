@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSubscriptions is a broker with fixed statistics.
+type fakeSubscriptions struct {
+	active  int
+	dropped uint64
+}
+
+func (s *fakeSubscriptions) Active() int     { return s.active }
+func (s *fakeSubscriptions) Dropped() uint64 { return s.dropped }
+
+func TestObserveCache(t *testing.T) {
+	m := New()
+	assert.Equal(t, 3, testutil.CollectAndCount(m.cacheRequests), "the results are exported before the first lookup")
+
+	m.ObserveCache("hit")
+	m.ObserveCache("hit")
+	m.ObserveCache("miss")
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.cacheRequests.WithLabelValues("hit")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.cacheRequests.WithLabelValues("miss")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.cacheRequests.WithLabelValues("error")))
+}
+
+func TestRegisterSubscriptions(t *testing.T) {
+	m := New()
+	source := &fakeSubscriptions{active: 2, dropped: 5}
+	m.RegisterSubscriptions("commentAdded", source)
+	source.active = 3
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `ozon_habr_subscriptions_active{subscription="commentAdded"} 3`, "the gauge reads the broker on every scrape")
+	assert.Contains(t, body, `ozon_habr_subscriptions_dropped_events_total{subscription="commentAdded"} 5`)
+}
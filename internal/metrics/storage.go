@@ -0,0 +1,198 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/storage"
+)
+
+// Storage measures the latency of every storage.StorageImp call.
+type Storage struct {
+	storage storage.StorageImp
+	metrics *Metrics
+}
+
+var _ storage.StorageImp = (*Storage)(nil)
+
+func (m *Metrics) Storage(storage storage.StorageImp) *Storage {
+	return &Storage{storage: storage, metrics: m}
+}
+
+func (s *Storage) observe(method string, start time.Time, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	s.metrics.storageDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+}
+
+func (s *Storage) AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.AddPost(ctx, newPost)
+	s.observe("AddPost", start, err)
+	return post, err
+}
+
+func (s *Storage) AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error) {
+	start := time.Now()
+	comment, err := s.storage.AddComment(ctx, postID, newComment)
+	s.observe("AddComment", start, err)
+	return comment, err
+}
+
+func (s *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.UpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	s.observe("UpdateEnableCommentToPost", start, err)
+	return post, err
+}
+
+func (s *Storage) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.PublishPost(ctx, postID, authorID)
+	s.observe("PublishPost", start, err)
+	return post, err
+}
+
+func (s *Storage) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.SchedulePost(ctx, postID, authorID, publishAt)
+	s.observe("SchedulePost", start, err)
+	return post, err
+}
+
+func (s *Storage) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	start := time.Now()
+	posts, err := s.storage.PublishDuePosts(ctx, now)
+	s.observe("PublishDuePosts", start, err)
+	return posts, err
+}
+
+func (s *Storage) GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error) {
+	start := time.Now()
+	posts, err := s.storage.GetAllPosts(ctx, opts)
+	s.observe("GetAllPosts", start, err)
+	return posts, err
+}
+
+func (s *Storage) GetAllPostsUnfiltered(ctx context.Context) ([]*model.Post, error) {
+	start := time.Now()
+	posts, err := s.storage.GetAllPostsUnfiltered(ctx)
+	s.observe("GetAllPostsUnfiltered", start, err)
+	return posts, err
+}
+
+func (s *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.GetPost(ctx, postID)
+	s.observe("GetPost", start, err)
+	return post, err
+}
+
+func (s *Storage) GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
+	start := time.Now()
+	comments, err := s.storage.GetCommentsBranch(ctx, postID, path)
+	s.observe("GetCommentsBranch", start, err)
+	return comments, err
+}
+
+func (s *Storage) GetCommentPath(ctx context.Context, commentID int64) (string, error) {
+	start := time.Now()
+	path, err := s.storage.GetCommentPath(ctx, commentID)
+	s.observe("GetCommentPath", start, err)
+	return path, err
+}
+
+func (s *Storage) GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error) {
+	start := time.Now()
+	branches, err := s.storage.GetCommentsBranches(ctx, keys)
+	s.observe("GetCommentsBranches", start, err)
+	return branches, err
+}
+
+func (s *Storage) GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+	start := time.Now()
+	paths, err := s.storage.GetCommentPaths(ctx, commentIDs)
+	s.observe("GetCommentPaths", start, err)
+	return paths, err
+}
+
+func (s *Storage) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	start := time.Now()
+	post, err := s.storage.VotePost(ctx, postID, userID, value)
+	s.observe("VotePost", start, err)
+	return post, err
+}
+
+func (s *Storage) GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error) {
+	start := time.Now()
+	votes, err := s.storage.GetPostVotes(ctx, userID, postIDs)
+	s.observe("GetPostVotes", start, err)
+	return votes, err
+}
+
+func (s *Storage) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	start := time.Now()
+	comment, err := s.storage.VoteComment(ctx, commentID, userID, value)
+	s.observe("VoteComment", start, err)
+	return comment, err
+}
+
+func (s *Storage) GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error) {
+	start := time.Now()
+	votes, err := s.storage.GetCommentVotes(ctx, userID, commentIDs)
+	s.observe("GetCommentVotes", start, err)
+	return votes, err
+}
+
+func (s *Storage) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	start := time.Now()
+	postID, changed, err := s.storage.AddReaction(ctx, key, userID, emoji)
+	s.observe("AddReaction", start, err)
+	return postID, changed, err
+}
+
+func (s *Storage) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	start := time.Now()
+	postID, changed, err := s.storage.RemoveReaction(ctx, key, userID, emoji)
+	s.observe("RemoveReaction", start, err)
+	return postID, changed, err
+}
+
+func (s *Storage) GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+	start := time.Now()
+	reactions, err := s.storage.GetReactions(ctx, keys, userID)
+	s.observe("GetReactions", start, err)
+	return reactions, err
+}
+
+func (s *Storage) Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	start := time.Now()
+	hits, err := s.storage.Search(ctx, opts)
+	s.observe("Search", start, err)
+	return hits, err
+}
+
+func (s *Storage) GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error) {
+	start := time.Now()
+	tags, err := s.storage.GetPostTags(ctx, postIDs)
+	s.observe("GetPostTags", start, err)
+	return tags, err
+}
+
+func (s *Storage) GetTags(ctx context.Context) ([]model.Tag, error) {
+	start := time.Now()
+	tags, err := s.storage.GetTags(ctx)
+	s.observe("GetTags", start, err)
+	return tags, err
+}
+
+func (s *Storage) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error) {
+	start := time.Now()
+	items, err := s.storage.GetUserActivity(ctx, authorID, after, limit, unpublished)
+	s.observe("GetUserActivity", start, err)
+	return items, err
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	inmemory "github.com/nabishec/ozon_habr_api/internal/storage/in-memory"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+	m := New()
+	storage := m.Storage(inmemory.NewStorage())
+
+	post, err := storage.AddPost(ctx, &model.NewPost{AuthorID: uuid.New(), Title: "title", Text: "text", Status: model.PostPublished})
+	require.NoError(t, err)
+	_, err = storage.GetPost(ctx, post.ID)
+	require.NoError(t, err)
+	_, err = storage.GetPost(ctx, post.ID+1)
+	require.ErrorIs(t, err, errs.ErrPostNotExist, "the errors are passed through")
+
+	const name = "ozon_habr_storage_method_duration_seconds"
+	assert.Equal(t, 3, testutil.CollectAndCount(m.storageDuration))
+	assert.EqualValues(t, 1, histogramCount(t, m, name, map[string]string{"method": "AddPost", "status": "ok"}))
+	assert.EqualValues(t, 1, histogramCount(t, m, name, map[string]string{"method": "GetPost", "status": "ok"}))
+	assert.EqualValues(t, 1, histogramCount(t, m, name, map[string]string{"method": "GetPost", "status": "error"}))
+}
@@ -1,33 +1,142 @@
 package model
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Comment struct {
-	ID         int64     `json:"id" db:"comment_id"`
-	AuthorID   uuid.UUID `json:"authorID" db:"author_id"`
-	PostID     int64     `json:"postID" db:"post_id"`
-	ParentID   *int64    `json:"parentID,omitempty" db:"parent_id"`
-	Path       string    `db:"path"`
-	Text       string    `json:"text" db:"text"`
-	CreateDate time.Time `json:"createDate" db:"create_date"`
+	ID         int64      `json:"id" db:"comment_id"`
+	AuthorID   uuid.UUID  `json:"authorID" db:"author_id"`
+	PostID     int64      `json:"postID" db:"post_id"`
+	ParentID   *int64     `json:"parentID,omitempty" db:"parent_id"`
+	Path       string     `db:"path"`
+	Text       string     `json:"text" db:"text"`
+	Format     TextFormat `json:"format" db:"format"`
+	CreateDate time.Time  `json:"createDate" db:"create_date"`
+	Score      int64      `json:"score" db:"score"`
+	// the counts are computed from the paths and kept in the cached branches
+	// together with the comment
+	RepliesCount     int64 `json:"repliesCount" db:"replies_count"`
+	DescendantsCount int64 `json:"descendantsCount" db:"descendants_count"`
+}
+
+// CommentOrder is the order of the comments of one branch.
+type CommentOrder string
+
+const (
+	// CommentOrderOldest is the order the branches are stored and cached in.
+	CommentOrderOldest CommentOrder = "oldest"
+	// CommentOrderTop puts the comments with the highest score first.
+	CommentOrderTop CommentOrder = "top"
+)
+
+// Level is the nesting level of the comment, the root comments are at level 1.
+func (c *Comment) Level() int {
+	return strings.Count(c.Path, ".") + 1
+}
+
+// PathCommentID returns the id of the last comment of the path, nil for the
+// empty path. A malformed path is an error, not a root comment.
+func PathCommentID(path string) (*int64, error) {
+	if path == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(path[strings.LastIndex(path, ".")+1:], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment path %q:%w", path, err)
+	}
+	return &id, nil
+}
+
+// AncestorIDs returns the ids of the comments above the comment from the root
+// one, they are the path without the comment itself.
+func (c *Comment) AncestorIDs() []int64 {
+	parts := strings.Split(c.Path, ".")
+	ids := make([]int64, 0, len(parts)-1)
+	for _, part := range parts[:len(parts)-1] {
+		id, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
 }
 
 type NewComment struct {
-	AuthorID uuid.UUID `json:"authorID"  db:"author_id"`
-	PostID   int64     `json:"postID" db:"post_id"`
-	ParentID *int64    `json:"parentID,omitempty" db:"parent_id"`
-	Text     string    `json:"text" db:"text"`
+	AuthorID uuid.UUID  `json:"authorID"  db:"author_id"`
+	PostID   int64      `json:"postID" db:"post_id"`
+	ParentID *int64     `json:"parentID,omitempty" db:"parent_id"`
+	Text     string     `json:"text" db:"text"`
+	Format   TextFormat `json:"format" db:"format"`
+	// Depth is the global limit, the post may override it
+	Depth CommentDepth `json:"-" db:"-"`
+	// CreateDate is set by import only, the zero one is the current time
+	CreateDate time.Time `json:"-" db:"-"`
+}
+
+// DepthMode is what happens to a reply that would be deeper than the limit.
+type DepthMode string
+
+const (
+	// DepthReject rejects the reply.
+	DepthReject DepthMode = "reject"
+	// DepthFlatten attaches the reply to the deepest allowed ancestor of its
+	// parent.
+	DepthFlatten DepthMode = "flatten"
+)
+
+// CommentDepth limits the nesting of the comments of a post, Max 0 means no
+// limit.
+type CommentDepth struct {
+	Max  int
+	Mode DepthMode
+}
+
+// ReplyParent returns the path of the comment the reply to the parent with
+// parentPath is attached to, empty for a root comment. ok is false if the
+// reply is rejected.
+func (d CommentDepth) ReplyParent(parentPath string) (path string, ok bool) {
+	if parentPath == "" || d.Max <= 0 || strings.Count(parentPath, ".")+1 < d.Max {
+		return parentPath, true
+	}
+	if d.Mode != DepthFlatten {
+		return "", false
+	}
+	parts := strings.Split(parentPath, ".")
+	return strings.Join(parts[:d.Max-1], "."), true
 }
 
+// TextFormat is the markup of the text of a post or a comment.
+type TextFormat string
+
+const (
+	FormatPlain    TextFormat = "plain"
+	FormatMarkdown TextFormat = "markdown"
+)
+
 type NewPost struct {
-	AuthorID        uuid.UUID `json:"authorID"  db:"author_id"`
-	Title           string    `json:"title" db:"title"`
-	Text            string    `json:"text" db:"text"`
-	CommentsEnabled bool      `json:"commentsEnabled" db:"comments_enabled"`
+	AuthorID        uuid.UUID  `json:"authorID"  db:"author_id"`
+	Title           string     `json:"title" db:"title"`
+	Text            string     `json:"text" db:"text"`
+	CommentsEnabled bool       `json:"commentsEnabled" db:"comments_enabled"`
+	Format          TextFormat `json:"format" db:"format"`
+	// Tags are normalized and unique
+	Tags []string `json:"tags,omitempty" db:"-"`
+	// Status is the status the post is created with, PublishAt is set only
+	// for the scheduled posts
+	Status    PostStatus `json:"status" db:"status"`
+	PublishAt *time.Time `json:"publishAt,omitempty" db:"publish_at"`
+	// the comment depth settings of the post, nil ones are the global
+	MaxCommentDepth  *int       `json:"maxCommentDepth,omitempty" db:"max_comment_depth"`
+	CommentDepthMode *DepthMode `json:"commentDepthMode,omitempty" db:"comment_depth_mode"`
+	// CreateDate is set by import only, the zero one is the current time
+	CreateDate time.Time `json:"-" db:"-"`
 }
 
 type Post struct {
@@ -38,4 +147,240 @@ type Post struct {
 	CommentsEnabled bool       `json:"commentsEnabled" db:"comments_enabled"`
 	Comments        []*Comment `json:"comments,omitempty"`
 	CreateDate      time.Time  `json:"createDate" db:"create_date"`
+	CommentsCount   int64      `json:"commentsCount" db:"comments_count"`
+	LastCommentAt   *time.Time `json:"lastCommentAt,omitempty" db:"last_comment_at"`
+	Score           int64      `json:"score" db:"score"`
+	Upvotes         int64      `json:"upvotes" db:"upvotes"`
+	Downvotes       int64      `json:"downvotes" db:"downvotes"`
+	Status          PostStatus `json:"status" db:"status"`
+	// PublishAt is when the post was or is going to be published, nil for drafts
+	PublishAt *time.Time `json:"publishAt,omitempty" db:"publish_at"`
+	Format    TextFormat `json:"format" db:"format"`
+	// MaxCommentDepth and CommentDepthMode override the global comment depth
+	// limit, the nil ones don't
+	MaxCommentDepth  *int       `json:"maxCommentDepth,omitempty" db:"max_comment_depth"`
+	CommentDepthMode *DepthMode `json:"commentDepthMode,omitempty" db:"comment_depth_mode"`
+}
+
+// CommentDepth returns the comment depth limit of the post, the settings of
+// the post override the global limit.
+func (p *Post) CommentDepth(global CommentDepth) CommentDepth {
+	depth := global
+	if p.MaxCommentDepth != nil {
+		depth.Max = *p.MaxCommentDepth
+	}
+	if p.CommentDepthMode != nil {
+		depth.Mode = *p.CommentDepthMode
+	}
+	return depth
+}
+
+// PostStatus is the publication status of a post, only the published posts
+// are visible to everyone.
+type PostStatus string
+
+const (
+	PostDraft     PostStatus = "draft"
+	PostScheduled PostStatus = "scheduled"
+	PostPublished PostStatus = "published"
+)
+
+// VisibleTo reports whether the viewer may see the post, the drafts and the
+// scheduled posts are visible only to their author.
+func (p *Post) VisibleTo(viewerID uuid.UUID) bool {
+	return p.Status == PostPublished || (viewerID != uuid.Nil && p.AuthorID == viewerID)
+}
+
+// VoteValue is the vote of one user, VoteNone removes the vote.
+type VoteValue int
+
+const (
+	VoteDown VoteValue = -1
+	VoteNone VoteValue = 0
+	VoteUp   VoteValue = 1
+)
+
+// counts returns the changes of the upvotes and the downvotes made by the
+// vote.
+func (v VoteValue) counts() (up, down int64) {
+	switch v {
+	case VoteUp:
+		return 1, 0
+	case VoteDown:
+		return 0, 1
+	}
+	return 0, 0
+}
+
+// VoteDelta returns the changes of the upvotes, the downvotes and the score
+// when a user changes the vote from the old value to the new one.
+func VoteDelta(old, new VoteValue) (up, down, score int64) {
+	oldUp, oldDown := old.counts()
+	newUp, newDown := new.counts()
+	return newUp - oldUp, newDown - oldDown, int64(new - old)
+}
+
+// PostOrderField is the column the posts list is sorted by.
+type PostOrderField string
+
+const (
+	PostOrderCreateDate    PostOrderField = "create_date"
+	PostOrderCommentsCount PostOrderField = "comments_count"
+	PostOrderLastCommentAt PostOrderField = "last_comment_at"
+	PostOrderScore         PostOrderField = "score"
+)
+
+// PostsOptions sets up the posts list, the zero value lists the posts from
+// the oldest one.
+type PostsOptions struct {
+	OrderBy PostOrderField
+	Desc    bool
+	// Tag selects the posts with the normalized tag, all posts when empty
+	Tag    string
+	Filter PostFilter
+	// ViewerID also lists the unpublished posts of the viewer, uuid.Nil
+	// lists only the published posts
+	ViewerID uuid.UUID
+}
+
+// PostFilter selects the posts matching all of its set fields, the dates are
+// exclusive bounds of the creation date.
+type PostFilter struct {
+	AuthorID        *uuid.UUID
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	CommentsEnabled *bool
+}
+
+// Match reports whether the post matches the filter.
+func (f PostFilter) Match(post *Post) bool {
+	if f.AuthorID != nil && post.AuthorID != *f.AuthorID {
+		return false
+	}
+	if f.CreatedAfter != nil && !post.CreateDate.After(*f.CreatedAfter) {
+		return false
+	}
+	if f.CreatedBefore != nil && !post.CreateDate.Before(*f.CreatedBefore) {
+		return false
+	}
+	if f.CommentsEnabled != nil && post.CommentsEnabled != *f.CommentsEnabled {
+		return false
+	}
+	return true
+}
+
+// BranchKey identifies the comments of the post under the path, the empty path
+// means the root comments.
+type BranchKey struct {
+	PostID int64
+	Path   string
+}
+
+// ReactionTarget is the kind of the entity a reaction is attached to.
+type ReactionTarget string
+
+const (
+	ReactionTargetPost    ReactionTarget = "post"
+	ReactionTargetComment ReactionTarget = "comment"
+)
+
+// ReactionKey identifies the post or the comment the reactions belong to.
+type ReactionKey struct {
+	Target ReactionTarget
+	ID     int64
+}
+
+// Reaction is the number of users who reacted with the emoji.
+type Reaction struct {
+	Emoji       string `db:"emoji"`
+	Count       int64  `db:"count"`
+	ReactedByMe bool   `db:"reacted_by_me"`
+}
+
+// SearchType is the kind of content a search looks through.
+type SearchType string
+
+const (
+	SearchPosts    SearchType = "post"
+	SearchComments SearchType = "comment"
+)
+
+// SearchOptions select a page of the hits of a search, the best ones first.
+type SearchOptions struct {
+	Query  string
+	Type   SearchType
+	Offset int
+	Limit  int
+}
+
+// SearchHit is a post or a comment matching a search. Snippet is an HTML
+// escaped fragment of the content with the matches wrapped in <b>.
+type SearchHit struct {
+	Post    *Post
+	Comment *Comment
+	Rank    float64
+	Snippet string
+}
+
+// Limits of the tags of a post, the length is in characters.
+const (
+	MaxTagLength = 32
+	MaxPostTags  = 10
+)
+
+// Tag is a tag with the number of posts tagged with it.
+type Tag struct {
+	Name       string `json:"name" db:"name"`
+	PostsCount int64  `json:"postsCount" db:"posts_count"`
+}
+
+// NormalizeTag lowercases the tag, trims it and collapses the spaces inside,
+// so "  Go   Lang " and "go lang" are the same tag.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.Join(strings.Fields(tag), " "))
+}
+
+// ActivityKind tells the posts from the comments in an activity feed.
+type ActivityKind string
+
+const (
+	ActivityPost    ActivityKind = "post"
+	ActivityComment ActivityKind = "comment"
+)
+
+// ActivityItem is a post or a comment of an author, PostTitle is the title of
+// the post or of the post the comment belongs to.
+type ActivityItem struct {
+	Post      *Post
+	Comment   *Comment
+	PostTitle string
+}
+
+// ActivityKey is the position of an item in the activity feed, the newest
+// items go first.
+type ActivityKey struct {
+	CreateDate time.Time
+	Kind       ActivityKind
+	ID         int64
+}
+
+// Key returns the position of the item in the feed.
+func (i *ActivityItem) Key() ActivityKey {
+	if i.Comment != nil {
+		return ActivityKey{CreateDate: i.Comment.CreateDate, Kind: ActivityComment, ID: i.Comment.ID}
+	}
+	return ActivityKey{CreateDate: i.Post.CreateDate, Kind: ActivityPost, ID: i.Post.ID}
+}
+
+// Before reports whether the item of k goes before the one of other in the
+// feed: it is newer, or a post created at the same time as a comment, or has a
+// greater id.
+func (k ActivityKey) Before(other ActivityKey) bool {
+	if c := k.CreateDate.Compare(other.CreateDate); c != 0 {
+		return c > 0
+	}
+	if k.Kind != other.Kind {
+		return k.Kind > other.Kind
+	}
+	return k.ID > other.ID
 }
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentAncestorIDs(t *testing.T) {
+	assert.Empty(t, (&Comment{Path: "1"}).AncestorIDs())
+	assert.Equal(t, []int64{1, 3}, (&Comment{Path: "1.3.4"}).AncestorIDs())
+}
+
+func TestPathCommentID(t *testing.T) {
+	id, err := PathCommentID("")
+	assert.NoError(t, err)
+	assert.Nil(t, id)
+
+	id, err = PathCommentID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), *id)
+
+	id, err = PathCommentID("1.3.4")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), *id)
+
+	_, err = PathCommentID("1.3.x")
+	assert.Error(t, err, "a malformed path isn't a root comment")
+}
+
+func TestCommentLevel(t *testing.T) {
+	assert.Equal(t, 1, (&Comment{Path: "1"}).Level())
+	assert.Equal(t, 3, (&Comment{Path: "1.3.4"}).Level())
+}
+
+func TestCommentDepthReplyParent(t *testing.T) {
+	tests := []struct {
+		name       string
+		depth      CommentDepth
+		parentPath string
+		want       string
+		ok         bool
+	}{
+		{"Root comment", CommentDepth{Max: 1}, "", "", true},
+		{"No limit", CommentDepth{}, "1.3.4.5", "1.3.4.5", true},
+		{"Below the limit", CommentDepth{Max: 3}, "1.3", "1.3", true},
+		{"Rejected", CommentDepth{Max: 3, Mode: DepthReject}, "1.3.4", "", false},
+		{"Rejected by default", CommentDepth{Max: 3}, "1.3.4", "", false},
+		{"Flattened", CommentDepth{Max: 3, Mode: DepthFlatten}, "1.3.4.5", "1.3", true},
+		{"Flattened to the root", CommentDepth{Max: 1, Mode: DepthFlatten}, "1", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, ok := tt.depth.ReplyParent(tt.parentPath)
+			assert.Equal(t, tt.want, path)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestPostCommentDepth(t *testing.T) {
+	global := CommentDepth{Max: 5, Mode: DepthReject}
+	unlimited, flatten := 0, DepthFlatten
+
+	assert.Equal(t, global, (&Post{}).CommentDepth(global))
+	assert.Equal(t, CommentDepth{Max: 0, Mode: DepthReject}, (&Post{MaxCommentDepth: &unlimited}).CommentDepth(global))
+	assert.Equal(t, CommentDepth{Max: 5, Mode: DepthFlatten}, (&Post{CommentDepthMode: &flatten}).CommentDepth(global))
+}
+
+func TestVoteDelta(t *testing.T) {
+	tests := []struct {
+		old, new        VoteValue
+		up, down, score int64
+	}{
+		{old: VoteNone, new: VoteUp, up: 1, score: 1},
+		{old: VoteUp, new: VoteDown, up: -1, down: 1, score: -2},
+		{old: VoteDown, new: VoteNone, down: -1, score: 1},
+		{old: VoteUp, new: VoteUp},
+	}
+
+	for _, tt := range tests {
+		up, down, score := VoteDelta(tt.old, tt.new)
+		assert.Equal(t, []int64{tt.up, tt.down, tt.score}, []int64{up, down, score}, "%d -> %d", tt.old, tt.new)
+	}
+}
+
+func TestNormalizeTag(t *testing.T) {
+	assert.Equal(t, "go lang", NormalizeTag("  Go \t Lang "))
+	assert.Equal(t, "хабр", NormalizeTag("ХАБР"))
+	assert.Equal(t, "", NormalizeTag("   "))
+}
+
+func TestPostFilterMatch(t *testing.T) {
+	authorID := uuid.New()
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	post := &Post{AuthorID: authorID, CreateDate: created, CommentsEnabled: true}
+
+	before, after := created.Add(-time.Hour), created.Add(time.Hour)
+	other := uuid.New()
+	disabled := false
+
+	assert.True(t, PostFilter{}.Match(post))
+	assert.True(t, PostFilter{AuthorID: &authorID, CreatedAfter: &before, CreatedBefore: &after}.Match(post))
+	assert.False(t, PostFilter{AuthorID: &other}.Match(post))
+	assert.False(t, PostFilter{CreatedAfter: &created}.Match(post), "the bounds are exclusive")
+	assert.False(t, PostFilter{CreatedBefore: &before}.Match(post))
+	assert.False(t, PostFilter{CommentsEnabled: &disabled}.Match(post))
+}
+
+func TestPostVisibleTo(t *testing.T) {
+	authorID := uuid.New()
+	draft := &Post{AuthorID: authorID, Status: PostDraft}
+
+	assert.True(t, draft.VisibleTo(authorID))
+	assert.False(t, draft.VisibleTo(uuid.New()))
+	assert.False(t, draft.VisibleTo(uuid.Nil))
+	assert.False(t, (&Post{Status: PostScheduled}).VisibleTo(uuid.Nil), "an anonymous viewer isn't the author of a post by the nil author")
+	assert.True(t, (&Post{AuthorID: authorID, Status: PostPublished}).VisibleTo(uuid.Nil))
+}
+
+func TestActivityKeyBefore(t *testing.T) {
+	now := time.Now()
+	newer := ActivityKey{CreateDate: now.Add(time.Second), Kind: ActivityComment, ID: 1}
+	post := ActivityKey{CreateDate: now, Kind: ActivityPost, ID: 1}
+	comment := ActivityKey{CreateDate: now, Kind: ActivityComment, ID: 2}
+
+	assert.True(t, newer.Before(post))
+	assert.True(t, post.Before(comment), "a post goes before a comment created at the same time")
+	assert.True(t, comment.Before(ActivityKey{CreateDate: now, Kind: ActivityComment, ID: 1}))
+	assert.False(t, post.Before(post))
+}
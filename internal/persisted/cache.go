@@ -0,0 +1,46 @@
+package persisted
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// RedisCache is the automatic persisted queries cache shared by the replicas,
+// the documents survive restarts.
+type RedisCache struct {
+	client redis.Cmdable
+	ttl    time.Duration
+}
+
+var _ graphql.Cache[string] = (*RedisCache)(nil)
+
+func NewRedisCache(client redis.Cmdable, ttl time.Duration) *RedisCache {
+	return &RedisCache{client: client, ttl: ttl}
+}
+
+func (c *RedisCache) Get(ctx context.Context, hash string) (string, bool) {
+	document, err := c.client.Get(ctx, key(hash)).Result()
+	if err != nil {
+		if err != redis.Nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("Failed get persisted query")
+		}
+		// the client sends the whole document again
+		return "", false
+	}
+	return document, true
+}
+
+func (c *RedisCache) Add(ctx context.Context, hash string, document string) {
+	err := c.client.Set(ctx, key(hash), document, c.ttl).Err()
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed save persisted query")
+	}
+}
+
+func key(hash string) string {
+	return "apq:" + hash
+}
@@ -0,0 +1,57 @@
+package persisted
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Manifest maps the sha256 hashes of the trusted documents to the documents.
+type Manifest map[string]string
+
+// apolloManifest is the format written by the Apollo client tooling, the
+// manifest may also be a plain {"<sha256>": "<document>"} object.
+type apolloManifest struct {
+	Format     string `json:"format"`
+	Operations []struct {
+		ID   string `json:"id"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+// LoadManifest reads the manifest and checks that every hash matches its document.
+func LoadManifest(path string) (Manifest, error) {
+	const op = "internal.persisted.LoadManifest()"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	manifest := Manifest{}
+
+	var apollo apolloManifest
+	if json.Unmarshal(data, &apollo) == nil && apollo.Format != "" {
+		for _, operation := range apollo.Operations {
+			manifest[operation.ID] = operation.Body
+		}
+	} else if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%s:failed parse %s:%w", op, path, err)
+	}
+
+	for hash, document := range manifest {
+		if Hash(document) != hash {
+			return nil, fmt.Errorf("%s:hash %s doesn't match its document", op, hash)
+		}
+	}
+
+	return manifest, nil
+}
+
+// Hash returns the hex sha256 of the document, as the clients compute it.
+func Hash(document string) string {
+	sum := sha256.Sum256([]byte(document))
+	return hex.EncodeToString(sum[:])
+}
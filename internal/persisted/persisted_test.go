@@ -0,0 +1,72 @@
+package persisted
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const document = "query Posts { posts { id } }"
+
+func writeManifest(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadManifest(t *testing.T) {
+	hash := Hash(document)
+
+	t.Run("Plain object", func(t *testing.T) {
+		manifest, err := LoadManifest(writeManifest(t, fmt.Sprintf(`{%q: %q}`, hash, document)))
+		require.NoError(t, err)
+		assert.Equal(t, document, manifest[hash])
+	})
+
+	t.Run("Apollo manifest", func(t *testing.T) {
+		manifest, err := LoadManifest(writeManifest(t, fmt.Sprintf(
+			`{"format": "apollo-persisted-query-manifest", "version": 1, "operations": [{"id": %q, "name": "Posts", "type": "query", "body": %q}]}`,
+			hash, document)))
+		require.NoError(t, err)
+		assert.Equal(t, document, manifest[hash])
+	})
+
+	t.Run("Hash mismatch", func(t *testing.T) {
+		_, err := LoadManifest(writeManifest(t, fmt.Sprintf(`{"abc": %q}`, document)))
+		assert.ErrorContains(t, err, "doesn't match")
+	})
+}
+
+func TestTrusted(t *testing.T) {
+	trusted := NewTrusted(Manifest{Hash(document): document})
+	ctx := context.Background()
+
+	t.Run("Known hash", func(t *testing.T) {
+		params := &graphql.RawParams{Extensions: map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": Hash(document)},
+		}}
+		assert.Nil(t, trusted.MutateOperationParameters(ctx, params))
+		assert.Equal(t, document, params.Query)
+	})
+
+	t.Run("Unknown hash", func(t *testing.T) {
+		params := &graphql.RawParams{Extensions: map[string]any{
+			"persistedQuery": map[string]any{"version": 1, "sha256Hash": "abc"},
+		}}
+		err := trusted.MutateOperationParameters(ctx, params)
+		require.NotNil(t, err)
+		assert.Equal(t, errNotFound, err.Extensions["code"])
+	})
+
+	t.Run("Arbitrary document", func(t *testing.T) {
+		err := trusted.MutateOperationParameters(ctx, &graphql.RawParams{Query: "{ posts { id title } }"})
+		require.NotNil(t, err)
+		assert.Equal(t, errNotAllowed, err.Extensions["code"])
+	})
+}
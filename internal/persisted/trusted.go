@@ -0,0 +1,62 @@
+package persisted
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	errNotFound   = "PERSISTED_QUERY_NOT_FOUND"
+	errNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+)
+
+// Trusted is a gqlgen extension that executes only the documents of the
+// manifest. Clients send the hash in the persistedQuery extension, like with
+// automatic persisted queries, or the whole document if it's in the manifest.
+type Trusted struct {
+	manifest Manifest
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = Trusted{}
+
+func NewTrusted(manifest Manifest) Trusted {
+	return Trusted{manifest: manifest}
+}
+
+func (Trusted) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+func (Trusted) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t Trusted) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if rawParams.Query != "" {
+		if _, ok := t.manifest[Hash(rawParams.Query)]; !ok {
+			err := gqlerror.Errorf("only trusted documents are allowed")
+			errcode.Set(err, errNotAllowed)
+			return err
+		}
+		return nil
+	}
+
+	persistedQuery, _ := rawParams.Extensions["persistedQuery"].(map[string]any)
+	hash, _ := persistedQuery["sha256Hash"].(string)
+
+	document, ok := t.manifest[hash]
+	if !ok {
+		err := gqlerror.Errorf("PersistedQueryNotFound")
+		errcode.Set(err, errNotFound)
+		return err
+	}
+	rawParams.Query = document
+
+	return nil
+}
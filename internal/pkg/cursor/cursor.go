@@ -2,8 +2,10 @@ package cursor
 
 import (
 	"encoding/base64"
+	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nabishec/ozon_habr_api/internal/model"
 )
@@ -56,6 +58,70 @@ func ValidateAfter(after *string) error {
 	return err
 }
 
+// searchPrefix tells the cursors of the search results from the comment ones.
+const searchPrefix = "search/"
+
+// CreateSearchCursor returns the cursor of the search hit at the offset.
+func CreateSearchCursor(offset int) string {
+	return base64.RawStdEncoding.EncodeToString([]byte(searchPrefix + strconv.Itoa(offset)))
+}
+
+// GetSearchOffset returns the offset of the search hit of the cursor.
+func GetSearchOffset(after *string) (int, error) {
+	cursorLine, err := decodeCursor(*after)
+	if err != nil {
+		return 0, err
+	}
+	offset, ok := strings.CutPrefix(cursorLine, searchPrefix)
+	if !ok {
+		return 0, errors.New("not a search cursor")
+	}
+	n, err := strconv.Atoi(offset)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, errors.New("negative offset")
+	}
+	return n, nil
+}
+
+// activityPrefix tells the cursors of the activity feed from the other ones.
+const activityPrefix = "activity/"
+
+// CreateActivityCursor returns the cursor of the activity item at the key.
+func CreateActivityCursor(key model.ActivityKey) string {
+	cursorLine := activityPrefix + string(key.Kind) + "/" + strconv.FormatInt(key.ID, 10) + "/" + strconv.FormatInt(key.CreateDate.UnixNano(), 10)
+	return base64.RawStdEncoding.EncodeToString([]byte(cursorLine))
+}
+
+// GetActivityKey returns the key of the activity item of the cursor.
+func GetActivityKey(after *string) (model.ActivityKey, error) {
+	cursorLine, err := decodeCursor(*after)
+	if err != nil {
+		return model.ActivityKey{}, err
+	}
+	rest, ok := strings.CutPrefix(cursorLine, activityPrefix)
+	parts := strings.Split(rest, "/")
+	if !ok || len(parts) != 3 {
+		return model.ActivityKey{}, errors.New("not an activity cursor")
+	}
+
+	kind := model.ActivityKind(parts[0])
+	if kind != model.ActivityPost && kind != model.ActivityComment {
+		return model.ActivityKey{}, errors.New("unknown activity kind")
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return model.ActivityKey{}, err
+	}
+	nanos, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return model.ActivityKey{}, err
+	}
+	return model.ActivityKey{CreateDate: time.Unix(0, nanos), Kind: kind, ID: id}, nil
+}
+
 func decodeCursor(after string) (string, error) {
 	cursorLine, err := base64.RawStdEncoding.DecodeString(after)
 	return string(cursorLine), err
@@ -0,0 +1,35 @@
+package cursor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchCursor(t *testing.T) {
+	c := CreateSearchCursor(7)
+	offset, err := GetSearchOffset(&c)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, offset)
+
+	commentCursor := CreateCursorFromComment(&model.Comment{Path: "1.2"})
+	_, err = GetSearchOffset(&commentCursor)
+	assert.Error(t, err)
+}
+
+func TestActivityCursor(t *testing.T) {
+	key := model.ActivityKey{CreateDate: time.Date(2025, 1, 1, 12, 0, 0, 123456789, time.UTC), Kind: model.ActivityComment, ID: 42}
+
+	c := CreateActivityCursor(key)
+	got, err := GetActivityKey(&c)
+	assert.NoError(t, err)
+	assert.True(t, key.CreateDate.Equal(got.CreateDate))
+	assert.Equal(t, key.Kind, got.Kind)
+	assert.Equal(t, key.ID, got.ID)
+
+	searchCursor := CreateSearchCursor(1)
+	_, err = GetActivityKey(&searchCursor)
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// BatchFunc loads the values of the keys at once. The values and the errors
+// are in the order of the keys, errors may be nil when every key is loaded.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]V, []error)
+
+// Loader collects the keys requested within the wait window into one call of
+// the batch function and remembers the results, so it must live no longer than
+// one request.
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[K]*result[V]
+	batch   *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	results []*result[V]
+}
+
+func New[K comparable, V any](fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		results:  make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value of the key, loading it with the other keys of the batch.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	res, ok := l.results[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.results[key] = res
+		l.add(ctx, key, res)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// add puts the key into the current batch, l.mu must be held.
+func (l *Loader[K, V]) add(ctx context.Context, key K, res *result[V]) {
+	b := l.batch
+	if b == nil {
+		b = &batch[K, V]{}
+		l.batch = b
+		time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			// the batch may have been dispatched already because it was full
+			if l.batch != b {
+				l.mu.Unlock()
+				return
+			}
+			l.batch = nil
+			l.mu.Unlock()
+
+			l.dispatch(ctx, b)
+		})
+	}
+
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, res)
+
+	if len(b.keys) >= l.maxBatch {
+		l.batch = nil
+		go l.dispatch(ctx, b)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	values, errs := l.fetch(ctx, b.keys)
+
+	for i, res := range b.results {
+		switch {
+		case len(values) != len(b.keys):
+			res.err = errors.Join(append([]error{errors.New("dataloader: batch function returned wrong number of values")}, errs...)...)
+		default:
+			res.value = values[i]
+			if i < len(errs) {
+				res.err = errs[i]
+			}
+		}
+		close(res.done)
+	}
+}
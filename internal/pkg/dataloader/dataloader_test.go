@@ -0,0 +1,79 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoader(t *testing.T) {
+	t.Run("Keys of the window are loaded in one batch", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]int
+
+		loader := New(func(ctx context.Context, keys []int) ([]int, []error) {
+			mu.Lock()
+			batches = append(batches, keys)
+			mu.Unlock()
+
+			values := make([]int, len(keys))
+			for i, k := range keys {
+				values[i] = k * 10
+			}
+			return values, nil
+		}, 10*time.Millisecond, 100)
+
+		var wg sync.WaitGroup
+		for _, key := range []int{1, 2, 3, 2} {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				v, err := loader.Load(context.Background(), key)
+				assert.NoError(t, err)
+				assert.Equal(t, key*10, v)
+			}(key)
+		}
+		wg.Wait()
+
+		assert.Len(t, batches, 1)
+		assert.ElementsMatch(t, []int{1, 2, 3}, batches[0])
+
+		// the value is remembered
+		v, err := loader.Load(context.Background(), 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 30, v)
+		assert.Len(t, batches, 1)
+	})
+
+	t.Run("Full batch is dispatched without waiting", func(t *testing.T) {
+		loader := New(func(ctx context.Context, keys []int) ([]int, []error) {
+			return make([]int, len(keys)), nil
+		}, time.Hour, 1)
+
+		_, err := loader.Load(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Errors are returned per key", func(t *testing.T) {
+		errNotFound := errors.New("not found")
+		loader := New(func(ctx context.Context, keys []int) ([]string, []error) {
+			return make([]string, len(keys)), []error{errNotFound}
+		}, time.Millisecond, 100)
+
+		_, err := loader.Load(context.Background(), 1)
+		assert.ErrorIs(t, err, errNotFound)
+	})
+
+	t.Run("Wrong number of values", func(t *testing.T) {
+		loader := New(func(ctx context.Context, keys []int) ([]string, []error) {
+			return nil, nil
+		}, time.Millisecond, 100)
+
+		_, err := loader.Load(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,50 @@
+package errs
+
+import (
+	"errors"
+)
+
+// Codes reported to clients in the extensions.code of a GraphQL error.
+const (
+	CodeNotFound         = "NOT_FOUND"
+	CodeForbidden        = "FORBIDDEN"
+	CodeValidation       = "VALIDATION"
+	CodeCommentsDisabled = "COMMENTS_DISABLED"
+	CodeRateLimited      = "RATE_LIMITED"
+	CodeInternal         = "INTERNAL"
+)
+
+var codes = []struct {
+	err  error
+	code string
+}{
+	{ErrPostNotExist, CodeNotFound},
+	{ErrPostsNotExist, CodeNotFound},
+	{ErrCommentsNotExist, CodeNotFound},
+	{ErrCommentNotExist, CodeNotFound},
+	{ErrPathNotExist, CodeNotFound},
+	{ErrParentCommentNotExist, CodeNotFound},
+	{ErrUnauthorizedAccess, CodeForbidden},
+	{ErrInvalidInput, CodeValidation},
+	{ErrCommentTooDeep, CodeValidation},
+	{ErrInvalidAfterCursor, CodeValidation},
+	{ErrInvalidVote, CodeValidation},
+	{ErrReactionNotAllowed, CodeValidation},
+	{ErrInvalidSearchQuery, CodeValidation},
+	{ErrInvalidPostFilter, CodeValidation},
+	{ErrPostAlreadyPublished, CodeValidation},
+	{ErrInvalidPublishAt, CodeValidation},
+	{ErrCommentsNotEnabled, CodeCommentsDisabled},
+	{ErrRateLimited, CodeRateLimited},
+}
+
+// Code returns the client code of err. Errors that aren't declared in this
+// package (and ErrPostNotCached, which never leaves the storage) are internal.
+func Code(err error) string {
+	for _, c := range codes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return CodeInternal
+}
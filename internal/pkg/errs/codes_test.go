@@ -0,0 +1,34 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"Not found", ErrPostNotExist, CodeNotFound},
+		{"Wrapped", fmt.Errorf("internal.handlers.GetPost():%w", ErrPostNotExist), CodeNotFound},
+		{"Forbidden", ErrUnauthorizedAccess, CodeForbidden},
+		{"Validation", ErrInvalidPostFilter, CodeValidation},
+		{"Invalid input", &ValidationError{Fields: []FieldError{{Field: "title", Message: "must not be empty"}}}, CodeValidation},
+		{"Comments disabled", ErrCommentsNotEnabled, CodeCommentsDisabled},
+		{"Rate limited", &RateLimitError{RetryAfter: time.Second}, CodeRateLimited},
+		{"Not cached", ErrPostNotCached, CodeInternal},
+		{"Unknown", errors.New("connection refused"), CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, Code(tt.err))
+		})
+	}
+}
@@ -2,17 +2,71 @@ package errs
 
 import (
 	"errors"
+	"strings"
+	"time"
 )
 
 var (
-	ErrPostNotExist           = errors.New("post not exist")
-	ErrUnauthorizedAccess     = errors.New("user doesn't have access rights")
-	ErrPostsNotExist          = errors.New("no posts have been created yet")
-	ErrCommentsNotExist       = errors.New("no comments have been created yet")
-	ErrPostNotCached          = errors.New("post not cached yet")
-	ErrPathNotExist           = errors.New("path not exist")
-	ErrParentCommentNotExist  = errors.New("parent comment not exist yet")
-	ErrIncorrectCommentLength = errors.New("incorrect comment length")
-	ErrCommentsNotEnabled     = errors.New("сomments on the post are not allowed")
-	ErrInvalidAfterCursor     = errors.New("invalid after cursor")
+	ErrPostNotExist          = errors.New("post not exist")
+	ErrUnauthorizedAccess    = errors.New("user doesn't have access rights")
+	ErrPostsNotExist         = errors.New("no posts have been created yet")
+	ErrCommentsNotExist      = errors.New("no comments have been created yet")
+	ErrCommentNotExist       = errors.New("comment not exist")
+	ErrPostNotCached         = errors.New("post not cached yet")
+	ErrPathNotExist          = errors.New("path not exist")
+	ErrParentCommentNotExist = errors.New("parent comment not exist yet")
+	ErrCommentsNotEnabled    = errors.New("comments on the post are not allowed")
+	ErrInvalidAfterCursor    = errors.New("invalid after cursor")
+	ErrRateLimited           = errors.New("rate limit exceeded")
+	ErrInvalidVote           = errors.New("invalid vote value")
+	ErrReactionNotAllowed    = errors.New("reaction is not allowed")
+	ErrInvalidSearchQuery    = errors.New("invalid search query")
+	ErrInvalidPostFilter     = errors.New("createdAfter must be before createdBefore")
+	ErrPostAlreadyPublished  = errors.New("post is already published")
+	ErrInvalidPublishAt      = errors.New("publishAt must be a future time of a scheduled post")
+	ErrInvalidInput          = errors.New("invalid input")
+	ErrCommentTooDeep        = errors.New("comment nesting is too deep")
 )
+
+// RateLimitError is ErrRateLimited with the time after which the request may
+// be retried.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return ErrRateLimited.Error()
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// FieldError is the problem with one field of the input.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is ErrInvalidInput with the problems of every invalid field.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrInvalidInput.Error())
+	for i, field := range e.Fields {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(field.Field + " " + field.Message)
+	}
+	return b.String()
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidInput
+}
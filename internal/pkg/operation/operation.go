@@ -0,0 +1,82 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Describe returns the name and type of the GraphQL operation in ctx. Requests
+// rejected before parsing have no operation context and are "unknown". The
+// name comes from the client, see RootField for the metric labels.
+func Describe(ctx context.Context) (name string, opType string) {
+	if !graphql.HasOperationContext(ctx) {
+		return "unknown", "unknown"
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	opType = "unknown"
+	if oc.Operation != nil {
+		opType = string(oc.Operation.Operation)
+	}
+
+	switch {
+	case oc.OperationName != "":
+		return oc.OperationName, opType
+	case oc.Operation != nil && oc.Operation.Name != "":
+		return oc.Operation.Name, opType
+	default:
+		return "anonymous", opType
+	}
+}
+
+// RootField returns the root field and the type of the GraphQL operation in
+// ctx. Unlike the name chosen by the client both are bounded by the schema,
+// so they are safe as metric labels: a field missing from the schema is
+// "unknown" and an operation with several root fields is "multiple".
+func RootField(ctx context.Context, schema *ast.Schema) (field string, opType string) {
+	if !graphql.HasOperationContext(ctx) {
+		return "unknown", "unknown"
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || schema == nil {
+		return "unknown", "unknown"
+	}
+	opType = string(oc.Operation.Operation)
+
+	var root *ast.Definition
+	switch oc.Operation.Operation {
+	case ast.Query:
+		root = schema.Query
+	case ast.Mutation:
+		root = schema.Mutation
+	case ast.Subscription:
+		root = schema.Subscription
+	}
+	if root == nil {
+		return "unknown", "unknown"
+	}
+
+	for _, f := range graphql.CollectFields(oc, oc.Operation.SelectionSet, nil) {
+		// the introspection fields aren't listed in the root type
+		if root.Fields.ForName(f.Name) == nil && !introspectionFields[f.Name] {
+			return "unknown", opType
+		}
+		if field != "" && field != f.Name {
+			return "multiple", opType
+		}
+		field = f.Name
+	}
+	if field == "" {
+		return "unknown", opType
+	}
+	return field, opType
+}
+
+var introspectionFields = map[string]bool{
+	"__typename": true,
+	"__schema":   true,
+	"__type":     true,
+}
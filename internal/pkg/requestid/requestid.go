@@ -0,0 +1,59 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Header carries the request id between services and back to the client.
+const Header = "X-Request-ID"
+
+// maxLength bounds ids coming from clients, they end up in every log line.
+const maxLength = 128
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the request id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the request id stored in ctx or an empty string.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// Middleware takes the request id from the X-Request-ID header or assigns a
+// new one, echoes it in the response and attaches a logger carrying the id to
+// the request context, so log.Ctx(ctx) lines can be correlated.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if !valid(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(Header, id)
+
+		logger := log.With().Str("request_id", id).Logger()
+		ctx := logger.WithContext(NewContext(r.Context(), id))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range []byte(id) {
+		// printable ascii only, ids must not break the log format
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+// Package search holds the text analysis of the in-memory full-text index and
+// the snippet formatting shared by the storages.
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The matches in a snippet are marked with StartSel and StopSel until
+// Highlight turns them into tags, so the text itself can be escaped.
+const (
+	StartSel = "\x02"
+	StopSel  = "\x03"
+)
+
+var markers = strings.NewReplacer(StartSel, "", StopSel, "")
+
+// StripMarkers removes StartSel and StopSel from the content, so only the
+// matches become tags.
+func StripMarkers(text string) string {
+	return markers.Replace(text)
+}
+
+// minStemLength keeps short words whole, "is" or "он" have no suffix to strip.
+const minStemLength = 3
+
+// suffixes are the endings stripped by Stem, the longest ones first.
+var (
+	russianSuffixes = []string{
+		"иями", "ями", "ами", "ого", "его", "ому", "ему", "ыми", "ими", "ией", "ость", "ах", "ях",
+		"ов", "ев", "ой", "ей", "ий", "ый", "ая", "яя", "ое", "ее", "ые", "ие", "ам", "ям",
+		"ом", "ем", "ую", "юю", "ть", "а", "я", "о", "е", "ы", "и", "у", "ю", "ь", "й",
+	}
+	englishSuffixes = []string{"ings", "ing", "ies", "ied", "ed", "es", "ly", "s"}
+)
+
+type word struct {
+	stem       string
+	start, end int
+}
+
+// words splits the text into the runs of letters and digits.
+func words(text string) []word {
+	var result []word
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWord && start == -1 {
+			start = i
+		}
+		if !isWord && start != -1 {
+			result = append(result, word{stem: Stem(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start != -1 {
+		result = append(result, word{stem: Stem(text[start:]), start: start, end: len(text)})
+	}
+	return result
+}
+
+// Terms returns the stems of the words of the text in their order.
+func Terms(text string) []string {
+	ws := words(text)
+	terms := make([]string, len(ws))
+	for i, w := range ws {
+		terms[i] = w.stem
+	}
+	return terms
+}
+
+// Stem lowercases the word and strips a common russian or english ending, it
+// is much simpler than the stemmers of Postgres but puts the usual word forms
+// together.
+func Stem(w string) string {
+	w = strings.ReplaceAll(strings.ToLower(w), "ё", "е")
+
+	suffixes := englishSuffixes
+	if strings.IndexFunc(w, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) != -1 {
+		suffixes = russianSuffixes
+	}
+	for _, suffix := range suffixes {
+		stem, ok := strings.CutSuffix(w, suffix)
+		if ok && utf8.RuneCountInString(stem) >= minStemLength {
+			return stem
+		}
+	}
+	return w
+}
+
+// Snippet returns up to maxWords words of the text around the first word whose
+// stem is in terms, with every such word marked.
+func Snippet(text string, terms map[string]struct{}, maxWords int) string {
+	text = StripMarkers(text)
+	ws := words(text)
+	if len(ws) == 0 {
+		return ""
+	}
+
+	first := 0
+	for i, w := range ws {
+		if _, ok := terms[w.stem]; ok {
+			first = i
+			break
+		}
+	}
+	from := max(0, min(first-maxWords/3, len(ws)-maxWords))
+	to := min(len(ws), from+maxWords)
+
+	var b strings.Builder
+	pos := ws[from].start
+	for _, w := range ws[from:to] {
+		if _, ok := terms[w.stem]; !ok {
+			continue
+		}
+		b.WriteString(text[pos:w.start])
+		b.WriteString(StartSel + text[w.start:w.end] + StopSel)
+		pos = w.end
+	}
+	b.WriteString(text[pos:ws[to-1].end])
+	return b.String()
+}
+
+// Highlight escapes the marked snippet for HTML and wraps the matches in <b>.
+func Highlight(marked string) string {
+	escaped := html.EscapeString(marked)
+	escaped = strings.ReplaceAll(escaped, StartSel, "<b>")
+	return strings.ReplaceAll(escaped, StopSel, "</b>")
+}
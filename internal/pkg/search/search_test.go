@@ -0,0 +1,52 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		stem string
+	}{
+		{"Кошки", "кошк"},
+		{"кошками", "кошк"},
+		{"Ёлка", "елк"},
+		{"cats", "cat"},
+		{"Testing", "test"},
+		{"is", "is"},
+		{"он", "он"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.stem, Stem(tt.word))
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	assert.Equal(t, []string{"кошк", "love", "cat", "42"}, Terms("Кошки love cats, 42!"))
+	assert.Empty(t, Terms(" .,- "))
+}
+
+func TestSnippet(t *testing.T) {
+	terms := map[string]struct{}{"cat": {}}
+
+	assert.Equal(t, "two "+StartSel+"cats"+StopSel+" three four five", Snippet("zero one two cats three four five", terms, 5))
+	assert.Equal(t, "a b", Snippet("a b", terms, 5), "a text without matches starts from the beginning")
+	assert.Equal(t, "", Snippet("", terms, 5))
+}
+
+func TestSnippetMarkersInText(t *testing.T) {
+	terms := map[string]struct{}{"cat": {}}
+
+	snippet := Snippet("cats "+StartSel+"and"+StopSel+" dogs", terms, 5)
+	assert.Equal(t, "<b>cats</b> and dogs", Highlight(snippet), "only the matches become tags")
+}
+
+func TestHighlight(t *testing.T) {
+	assert.Equal(t, "&lt;script&gt; <b>cats</b>", Highlight("<script> "+StartSel+"cats"+StopSel))
+}
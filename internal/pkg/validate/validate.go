@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+)
+
+// Limits bound the texts of the users in characters.
+type Limits struct {
+	TitleMaxLength       int
+	PostTextMaxLength    int
+	CommentTextMaxLength int
+}
+
+// Validator collects the problems of every field of an input, so the client
+// gets all of them at once.
+type Validator struct {
+	fields []errs.FieldError
+}
+
+// Add records the problem with the field.
+func (v *Validator) Add(field, message string) {
+	v.fields = append(v.fields, errs.FieldError{Field: field, Message: message})
+}
+
+// Line checks a single line text, like a title, and returns it trimmed.
+func (v *Validator) Line(field, value string, maxLength int) string {
+	return v.text(field, value, maxLength, false)
+}
+
+// Text checks a multiline text and returns it without the trailing whitespace
+// and the leading blank lines, the indentation of the first line is kept, it
+// starts a code block in markdown. Line breaks and tabs are the only control
+// characters allowed.
+func (v *Validator) Text(field, value string, maxLength int) string {
+	return v.text(field, value, maxLength, true)
+}
+
+func (v *Validator) text(field, value string, maxLength int, multiline bool) string {
+	if !utf8.ValidString(value) {
+		v.Add(field, "must be valid UTF-8")
+		return value
+	}
+
+	if multiline {
+		value = strings.TrimRightFunc(value, unicode.IsSpace)
+		start := strings.IndexFunc(value, func(r rune) bool { return !unicode.IsSpace(r) })
+		value = value[strings.LastIndexByte(value[:max(start, 0)], '\n')+1:]
+	} else {
+		value = strings.TrimSpace(value)
+	}
+	length := 0
+	for _, r := range value {
+		if unicode.IsControl(r) && !(multiline && (r == '\n' || r == '\r' || r == '\t')) {
+			v.Add(field, "must not contain control characters")
+			return value
+		}
+		length++
+	}
+
+	switch {
+	case length == 0:
+		v.Add(field, "must not be empty")
+	case length > maxLength:
+		v.Add(field, fmt.Sprintf("must be at most %d characters", maxLength))
+	}
+	return value
+}
+
+// Author checks that the id of the author is set.
+func (v *Validator) Author(field string, id uuid.UUID) {
+	if id == uuid.Nil {
+		v.Add(field, "must not be nil UUID")
+	}
+}
+
+// Err returns the *errs.ValidationError with the recorded problems, or nil if
+// the input is valid.
+func (v *Validator) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &errs.ValidationError{Fields: v.fields}
+}
+
+// Author returns the validation error if the id of the author isn't set.
+func Author(id uuid.UUID) error {
+	var v Validator
+	v.Author("authorID", id)
+	return v.Err()
+}
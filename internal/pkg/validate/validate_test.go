@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		check     func(v *Validator) string
+		want      string
+		wantField []errs.FieldError
+	}{
+		{
+			name:  "Text is trimmed",
+			check: func(v *Validator) string { return v.Text("text", " \n first\n\tsecond \n", 20) },
+			want:  " first\n\tsecond",
+		},
+		{
+			name:  "Indented code block is kept",
+			check: func(v *Validator) string { return v.Text("text", "\n\n    code\n    more\n\n", 20) },
+			want:  "    code\n    more",
+		},
+		{
+			name:      "Blank text is empty",
+			check:     func(v *Validator) string { return v.Text("text", " \n\t\n ", 20) },
+			want:      "",
+			wantField: []errs.FieldError{{Field: "text", Message: "must not be empty"}},
+		},
+		{
+			name:      "Whitespace only is empty",
+			check:     func(v *Validator) string { return v.Line("title", " \t ", 20) },
+			want:      "",
+			wantField: []errs.FieldError{{Field: "title", Message: "must not be empty"}},
+		},
+		{
+			name:  "Length is in characters",
+			check: func(v *Validator) string { return v.Line("title", "привет", 6) },
+			want:  "привет",
+		},
+		{
+			name:      "Too long",
+			check:     func(v *Validator) string { return v.Text("text", strings.Repeat("a", 7), 6) },
+			want:      "aaaaaaa",
+			wantField: []errs.FieldError{{Field: "text", Message: "must be at most 6 characters"}},
+		},
+		{
+			name:      "Invalid UTF-8",
+			check:     func(v *Validator) string { return v.Text("text", "a\xffb", 6) },
+			want:      "a\xffb",
+			wantField: []errs.FieldError{{Field: "text", Message: "must be valid UTF-8"}},
+		},
+		{
+			name:      "Line break in a line",
+			check:     func(v *Validator) string { return v.Line("title", "a\nb", 6) },
+			want:      "a\nb",
+			wantField: []errs.FieldError{{Field: "title", Message: "must not contain control characters"}},
+		},
+		{
+			name:      "Control character in a text",
+			check:     func(v *Validator) string { return v.Text("text", "a\x00b", 6) },
+			want:      "a\x00b",
+			wantField: []errs.FieldError{{Field: "text", Message: "must not contain control characters"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Validator
+			assert.Equal(t, tt.want, tt.check(&v))
+
+			err := v.Err()
+			if tt.wantField == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, errs.ErrInvalidInput)
+			assert.Equal(t, &errs.ValidationError{Fields: tt.wantField}, err)
+		})
+	}
+
+	t.Run("All fields are reported", func(t *testing.T) {
+		var v Validator
+		v.Author("authorID", uuid.Nil)
+		v.Line("title", "", 6)
+		v.Text("text", "text", 6)
+
+		err := v.Err()
+		assert.Equal(t, &errs.ValidationError{Fields: []errs.FieldError{
+			{Field: "authorID", Message: "must not be nil UUID"},
+			{Field: "title", Message: "must not be empty"},
+		}}, err)
+		assert.EqualError(t, err, "invalid input: authorID must not be nil UUID; title must not be empty")
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		assert.NoError(t, Author(uuid.New()))
+		assert.ErrorIs(t, Author(uuid.Nil), errs.ErrInvalidInput)
+	})
+}
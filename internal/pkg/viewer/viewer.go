@@ -0,0 +1,38 @@
+package viewer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Header carries the id of the user the per-user fields (like Post.myVote)
+// are resolved for.
+const Header = "X-User-ID"
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the viewer id.
+func NewContext(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the viewer id stored in ctx, ok is false for anonymous
+// requests.
+func FromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(ctxKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// Middleware stores the id from the X-User-ID header in the request context.
+// A missing or malformed header leaves the request anonymous.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(r.Header.Get(Header))
+		if err == nil && id != uuid.Nil {
+			r = r.WithContext(NewContext(r.Context(), id))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
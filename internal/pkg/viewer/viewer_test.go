@@ -0,0 +1,44 @@
+package viewer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		ok     bool
+	}{
+		{name: "Valid id", header: "11111111-1111-1111-1111-111111111111", ok: true},
+		{name: "No header", header: "", ok: false},
+		{name: "Malformed id", header: "not-a-uuid", ok: false},
+		{name: "Nil id", header: uuid.Nil.String(), ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var id uuid.UUID
+			var ok bool
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				id, ok = FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.header, id.String())
+			}
+		})
+	}
+}
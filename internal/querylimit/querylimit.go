@@ -0,0 +1,113 @@
+package querylimit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	errDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+	errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+)
+
+const extensionName = "QueryLimit"
+
+// Limit is a gqlgen extension that rejects operations nested deeper than
+// MaxDepth or more complex than MaxComplexity before they are executed.
+type Limit struct {
+	MaxDepth      int
+	MaxComplexity int
+
+	schema graphql.ExecutableSchema
+}
+
+// Stats are the measures of the current operation.
+type Stats struct {
+	Depth      int
+	Complexity int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Limit{}
+
+func New(maxDepth, maxComplexity int) *Limit {
+	return &Limit{MaxDepth: maxDepth, MaxComplexity: maxComplexity}
+}
+
+func (l Limit) ExtensionName() string {
+	return extensionName
+}
+
+func (l *Limit) Validate(schema graphql.ExecutableSchema) error {
+	l.schema = schema
+	return nil
+}
+
+func (l Limit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	stats := &Stats{
+		Depth:      Depth(opCtx.Operation.SelectionSet),
+		Complexity: complexity.Calculate(l.schema, opCtx.Operation, opCtx.Variables),
+	}
+	opCtx.Stats.SetExtension(extensionName, stats)
+
+	if stats.Depth > l.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", stats.Depth, l.MaxDepth)
+		errcode.Set(err, errDepthLimit)
+		err.Extensions["depth"] = stats.Depth
+		err.Extensions["maxDepth"] = l.MaxDepth
+		return err
+	}
+
+	if stats.Complexity > l.MaxComplexity {
+		err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", stats.Complexity, l.MaxComplexity)
+		errcode.Set(err, errComplexityLimit)
+		err.Extensions["complexity"] = stats.Complexity
+		err.Extensions["maxComplexity"] = l.MaxComplexity
+		return err
+	}
+
+	return nil
+}
+
+// GetStats returns the measures of the operation in ctx or nil if it wasn't
+// measured.
+func GetStats(ctx context.Context) *Stats {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	stats, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(extensionName).(*Stats)
+	return stats
+}
+
+// Depth returns the number of nested fields in the deepest branch of the
+// selection set. Introspection fields aren't counted, the schema query of the
+// playground is deep by design.
+func Depth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + Depth(s.SelectionSet)
+		case *ast.InlineFragment:
+			d = Depth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = Depth(s.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
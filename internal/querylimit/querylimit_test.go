@@ -0,0 +1,31 @@
+package querylimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestDepth(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		depth int
+	}{
+		{"Flat", `{ posts { id } }`, 2},
+		{"Nested replies", `{ post(postID: 1) { comments { edges { node { replies { edges { node { id } } } } } } } }`, 8},
+		{"Inline fragment", `{ post(postID: 1) { ... on Post { comments { edges { cursor } } } } }`, 4},
+		{"Introspection isn't counted", `{ __schema { types { fields { type { name } } } } posts { id } }`, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.ParseQuery(&ast.Source{Input: tt.query})
+			require.NoError(t, err)
+			assert.Equal(t, tt.depth, Depth(doc.Operations[0].SelectionSet))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/rs/zerolog/log"
+)
+
+// GraphQL is a gqlgen extension that limits calls of the root fields that have
+// a rule, per author and per client ip.
+type GraphQL struct {
+	limiter Limiter
+	rules   map[string]config.RateLimitRule
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = GraphQL{}
+
+func NewGraphQL(limiter Limiter, rules map[string]config.RateLimitRule) GraphQL {
+	return GraphQL{limiter: limiter, rules: rules}
+}
+
+func (GraphQL) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (GraphQL) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	// only root fields have rules
+	if fc == nil || len(fc.Path()) != 1 {
+		return next(ctx)
+	}
+	rule, ok := e.rules[fc.Field.Name]
+	if !ok {
+		return next(ctx)
+	}
+
+	var authorKey string
+	if author := authorID(ctx, fc); author != "" {
+		authorKey = fc.Field.Name + ":author:" + author
+		err := e.allow(ctx, authorKey, rule.PerAuthor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ip := ClientIP(ctx); ip != "" {
+		err := e.allow(ctx, fc.Field.Name+":ip:"+ip, rule.PerIP)
+		if err != nil {
+			// the rejected call doesn't cost the author anything
+			if authorKey != "" {
+				e.refund(ctx, authorKey, rule.PerAuthor)
+			}
+			return nil, err
+		}
+	}
+
+	return next(ctx)
+}
+
+func (e GraphQL) allow(ctx context.Context, key string, limit config.Limit) error {
+	if limit.Requests == 0 {
+		return nil
+	}
+
+	allowed, retryAfter, err := e.limiter.Allow(ctx, key, limit)
+	if err != nil {
+		// an unavailable limiter must not take the api down with it
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Rate limiter failed, request allowed")
+		return nil
+	}
+	if !allowed {
+		return &errs.RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+func (e GraphQL) refund(ctx context.Context, key string, limit config.Limit) {
+	if limit.Requests == 0 {
+		return
+	}
+
+	err := e.limiter.Refund(ctx, key, limit)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("key", key).Msg("Rate limiter failed to refund a token")
+	}
+}
+
+// authorID finds the authorID argument of the field, either top-level or in an
+// input object, as the client sent it.
+func authorID(ctx context.Context, fc *graphql.FieldContext) string {
+	args := fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	if id, ok := args["authorID"]; ok {
+		return fmt.Sprint(id)
+	}
+	for _, arg := range args {
+		if input, ok := arg.(map[string]any); ok {
+			if id, ok := input["authorID"]; ok {
+				return fmt.Sprint(id)
+			}
+		}
+	}
+	return ""
+}
+
+type ctxKey struct{}
+
+// ClientIP returns the client ip stored by ClientIPMiddleware.
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(ctxKey{}).(string)
+	return ip
+}
+
+// ClientIPMiddleware stores the client ip in the request context. With
+// trustProxy the leftmost X-Forwarded-For address is used.
+func ClientIPMiddleware(trustProxy bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		if trustProxy {
+			if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+				ip = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, ip)))
+	})
+}
@@ -0,0 +1,172 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var testSchema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+type Query { posts: [Int!]! }
+type Mutation {
+	addPost(postInput: NewPost!): Int!
+	votePost(postID: ID!, authorID: ID!): Int!
+	addTag(name: String!): Int!
+}
+input NewPost { authorID: ID!, title: String! }
+`})
+
+// fakeLimiter allows every key except the rejected ones and records the calls.
+type fakeLimiter struct {
+	rejected map[string]time.Duration
+	err      error
+	allowed  []string
+	refunded []string
+}
+
+func (l *fakeLimiter) Allow(_ context.Context, key string, _ config.Limit) (bool, time.Duration, error) {
+	if l.err != nil {
+		return false, 0, l.err
+	}
+	if retryAfter, ok := l.rejected[key]; ok {
+		return false, retryAfter, nil
+	}
+	l.allowed = append(l.allowed, key)
+	return true, 0, nil
+}
+
+func (l *fakeLimiter) Refund(_ context.Context, key string, _ config.Limit) error {
+	l.refunded = append(l.refunded, key)
+	return nil
+}
+
+// fieldContext returns the context gqlgen builds for the first root field of
+// the query.
+func fieldContext(t *testing.T, ip, query string, vars map[string]any) context.Context {
+	t.Helper()
+
+	doc, gqlErr := gqlparser.LoadQuery(testSchema, query)
+	require.Nil(t, gqlErr)
+	field := doc.Operations[0].SelectionSet[0].(*ast.Field)
+
+	ctx := context.Background()
+	if ip != "" {
+		ctx = context.WithValue(ctx, ctxKey{}, ip)
+	}
+	ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{Variables: vars})
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: field},
+	})
+}
+
+func TestGraphQL(t *testing.T) {
+	limit := config.Limit{Requests: 1, Period: time.Minute}
+	rules := map[string]config.RateLimitRule{
+		"addPost":  {PerAuthor: limit, PerIP: limit},
+		"votePost": {PerAuthor: limit, PerIP: limit},
+	}
+	next := func(context.Context) (any, error) { return 1, nil }
+
+	t.Run("Author from the field arguments", func(t *testing.T) {
+		limiter := &fakeLimiter{}
+		ctx := fieldContext(t, "10.0.0.1", `mutation { votePost(postID: "1", authorID: "a1") }`, nil)
+
+		res, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res)
+		assert.Equal(t, []string{"votePost:author:a1", "votePost:ip:10.0.0.1"}, limiter.allowed)
+	})
+
+	t.Run("Author from an input object variable", func(t *testing.T) {
+		limiter := &fakeLimiter{}
+		ctx := fieldContext(t, "", `mutation($in: NewPost!) { addPost(postInput: $in) }`,
+			map[string]any{"in": map[string]any{"authorID": "a2", "title": "title"}})
+
+		_, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"addPost:author:a2"}, limiter.allowed)
+	})
+
+	t.Run("Fields without a rule", func(t *testing.T) {
+		limiter := &fakeLimiter{}
+		ctx := fieldContext(t, "10.0.0.1", `mutation { addTag(name: "go") }`, nil)
+
+		_, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.NoError(t, err)
+		assert.Empty(t, limiter.allowed)
+	})
+
+	t.Run("Rejected author", func(t *testing.T) {
+		limiter := &fakeLimiter{rejected: map[string]time.Duration{"votePost:author:a1": 30 * time.Second}}
+		ctx := fieldContext(t, "10.0.0.1", `mutation { votePost(postID: "1", authorID: "a1") }`, nil)
+
+		_, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.ErrorIs(t, err, errs.ErrRateLimited)
+		assert.Equal(t, errs.CodeRateLimited, errs.Code(err))
+
+		var rateErr *errs.RateLimitError
+		require.True(t, errors.As(err, &rateErr))
+		assert.Equal(t, 30*time.Second, rateErr.RetryAfter)
+		assert.Empty(t, limiter.allowed)
+	})
+
+	t.Run("Rejected ip refunds the author", func(t *testing.T) {
+		limiter := &fakeLimiter{rejected: map[string]time.Duration{"votePost:ip:10.0.0.1": time.Second}}
+		ctx := fieldContext(t, "10.0.0.1", `mutation { votePost(postID: "1", authorID: "a1") }`, nil)
+
+		_, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.ErrorIs(t, err, errs.ErrRateLimited)
+		assert.Equal(t, []string{"votePost:author:a1"}, limiter.refunded)
+	})
+
+	t.Run("Failing limiter allows the call", func(t *testing.T) {
+		limiter := &fakeLimiter{err: errors.New("connection refused")}
+		ctx := fieldContext(t, "10.0.0.1", `mutation { votePost(postID: "1", authorID: "a1") }`, nil)
+
+		res, err := NewGraphQL(limiter, rules).InterceptField(ctx, next)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res)
+	})
+}
+
+func TestClientIPMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		trustProxy bool
+		forwarded  string
+		want       string
+	}{
+		{name: "Remote address", want: "192.0.2.1"},
+		{name: "Untrusted proxy header", forwarded: "203.0.113.5", want: "192.0.2.1"},
+		{name: "Trusted proxy header", trustProxy: true, forwarded: "203.0.113.5, 10.0.0.1", want: "203.0.113.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := ClientIPMiddleware(tt.trustProxy, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = ClientIP(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodPost, "/query", nil)
+			r.RemoteAddr = "192.0.2.1:1234"
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
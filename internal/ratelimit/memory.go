@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+)
+
+// sweepEvery is the number of Allow calls between sweeps of full buckets.
+const sweepEvery = 1024
+
+// Memory keeps the buckets in process, the limits hold for one replica only.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	calls   int
+
+	now func() time.Time
+}
+
+type memoryBucket struct {
+	bucket
+	// full is the moment the bucket refills completely and can be forgotten
+	full time.Time
+}
+
+var _ Limiter = (*Memory)(nil)
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memoryBucket{bucket: bucket{tokens: float64(limit.Requests), last: now}}
+		m.buckets[key] = b
+	}
+
+	allowed, retryAfter := b.take(now, limit)
+	missing := float64(limit.Requests) - b.tokens
+	b.full = now.Add(time.Duration(missing * float64(limit.Period) / float64(limit.Requests)))
+
+	return allowed, retryAfter, nil
+}
+
+func (m *Memory) Refund(ctx context.Context, key string, limit config.Limit) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if b, ok := m.buckets[key]; ok {
+		b.refund(limit)
+	}
+	return nil
+}
+
+// sweep drops the buckets that are full again, a new bucket starts full anyway.
+func (m *Memory) sweep(now time.Time) {
+	m.calls++
+	if m.calls < sweepEvery {
+		return
+	}
+	m.calls = 0
+
+	for key, b := range m.buckets {
+		if !now.Before(b.full) {
+			delete(m.buckets, key)
+		}
+	}
+}
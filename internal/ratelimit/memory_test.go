@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	limit := config.Limit{Requests: 2, Period: time.Minute}
+
+	now := time.Now()
+	limiter := NewMemory()
+	limiter.now = func() time.Time { return now }
+
+	t.Run("Burst up to the limit", func(t *testing.T) {
+		for range limit.Requests {
+			allowed, _, err := limiter.Allow(ctx, "author:1", limit)
+			require.NoError(t, err)
+			assert.True(t, allowed)
+		}
+
+		allowed, retryAfter, err := limiter.Allow(ctx, "author:1", limit)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, 30*time.Second, retryAfter)
+	})
+
+	t.Run("Keys are independent", func(t *testing.T) {
+		allowed, _, err := limiter.Allow(ctx, "author:2", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("Tokens are refilled", func(t *testing.T) {
+		now = now.Add(30 * time.Second)
+
+		allowed, _, err := limiter.Allow(ctx, "author:1", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, _, err = limiter.Allow(ctx, "author:1", limit)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func TestMemoryRefund(t *testing.T) {
+	ctx := context.Background()
+	limit := config.Limit{Requests: 1, Period: time.Minute}
+	limiter := NewMemory()
+
+	allowed, _, err := limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, limiter.Refund(ctx, "ip:1", limit))
+	require.NoError(t, limiter.Refund(ctx, "ip:1", limit))
+
+	// refunds never go over the capacity
+	allowed, _, err = limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, _, err = limiter.Allow(ctx, "ip:1", limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+)
+
+// Limiter is a token bucket per key: the bucket holds up to limit.Requests
+// tokens and is refilled with limit.Requests tokens every limit.Period.
+type Limiter interface {
+	// Allow takes a token from the bucket of the key. When the bucket is empty
+	// it reports how long to wait for the next token.
+	Allow(ctx context.Context, key string, limit config.Limit) (allowed bool, retryAfter time.Duration, err error)
+	// Refund puts back the token taken by an allowed call that didn't go
+	// through in the end.
+	Refund(ctx context.Context, key string, limit config.Limit) error
+}
+
+// bucket is the state shared by the memory and redis implementations.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket up to now and takes a token if there is one.
+func (b *bucket) take(now time.Time, limit config.Limit) (bool, time.Duration) {
+	capacity := float64(limit.Requests)
+	rate := capacity / float64(limit.Period) // tokens per nanosecond
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(capacity, b.tokens+float64(elapsed)*rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / rate)
+}
+
+// refund puts a token back, the bucket never holds more than its capacity.
+func (b *bucket) refund(limit config.Limit) {
+	b.tokens = min(float64(limit.Requests), b.tokens+1)
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript is bucket.take running atomically in redis. The redis clock is
+// used so replicas with skewed clocks share the same buckets.
+var takeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local rate = capacity / period
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'last')
+local tokens = tonumber(state[1]) or capacity
+local last = tonumber(state[2]) or now
+
+if now > last then
+	tokens = math.min(capacity, tokens + (now - last) * rate)
+	last = now
+end
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'last', tostring(last))
+redis.call('PEXPIRE', KEYS[1], math.ceil(period / 1000))
+return {allowed, retry}
+`)
+
+// refundScript is bucket.refund, a missing bucket is full anyway.
+var refundScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local tokens = tonumber(redis.call('HGET', KEYS[1], 'tokens'))
+if tokens then
+	redis.call('HSET', KEYS[1], 'tokens', tostring(math.min(capacity, tokens + 1)))
+end
+return 1
+`)
+
+// Redis keeps the buckets in the redis ring, so the limits hold across replicas.
+type Redis struct {
+	client redis.Scripter
+}
+
+var _ Limiter = (*Redis)(nil)
+
+func NewRedis(client redis.Scripter) *Redis {
+	return &Redis{client: client}
+}
+
+func (r *Redis) Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	const op = "internal.ratelimit.Redis.Allow()"
+
+	res, err := takeScript.Run(ctx, r.client, []string{"ratelimit:" + key},
+		limit.Requests, limit.Period.Microseconds()).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("%s:%w", op, err)
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("%s:unexpected script result %v", op, res)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Microsecond, nil
+}
+
+func (r *Redis) Refund(ctx context.Context, key string, limit config.Limit) error {
+	const op = "internal.ratelimit.Redis.Refund()"
+
+	err := refundScript.Run(ctx, r.client, []string{"ratelimit:" + key}, limit.Requests).Err()
+	if err != nil {
+		return fmt.Errorf("%s:%w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScripter returns a canned script result and records what was sent.
+type fakeScripter struct {
+	redis.Scripter
+	result any
+	err    error
+	keys   []string
+	args   []any
+}
+
+func (s *fakeScripter) EvalSha(_ context.Context, _ string, keys []string, args ...any) *redis.Cmd {
+	s.keys, s.args = keys, args
+	return redis.NewCmdResult(s.result, s.err)
+}
+
+func TestRedisAllow(t *testing.T) {
+	ctx := context.Background()
+	limit := config.Limit{Requests: 5, Period: time.Minute}
+
+	t.Run("Allowed", func(t *testing.T) {
+		client := &fakeScripter{result: []any{int64(1), int64(0)}}
+
+		allowed, retryAfter, err := NewRedis(client).Allow(ctx, "addPost:ip:10.0.0.1", limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Zero(t, retryAfter)
+		assert.Equal(t, []string{"ratelimit:addPost:ip:10.0.0.1"}, client.keys)
+		assert.Equal(t, []any{5, int64(60_000_000)}, client.args)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		client := &fakeScripter{result: []any{int64(0), int64(12_000_000)}}
+
+		allowed, retryAfter, err := NewRedis(client).Allow(ctx, "addPost:ip:10.0.0.1", limit)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+		assert.Equal(t, 12*time.Second, retryAfter)
+	})
+
+	t.Run("Redis error", func(t *testing.T) {
+		client := &fakeScripter{err: errors.New("connection refused")}
+
+		_, _, err := NewRedis(client).Allow(ctx, "addPost:ip:10.0.0.1", limit)
+		require.Error(t, err)
+	})
+
+	t.Run("Unexpected result", func(t *testing.T) {
+		client := &fakeScripter{result: []any{int64(1)}}
+
+		_, _, err := NewRedis(client).Allow(ctx, "addPost:ip:10.0.0.1", limit)
+		require.Error(t, err)
+	})
+}
+
+// TestRedisScript runs the scripts against a real redis given by
+// RATELIMIT_TEST_REDIS_ADDR.
+func TestRedisScript(t *testing.T) {
+	addr := os.Getenv("RATELIMIT_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("RATELIMIT_TEST_REDIS_ADDR is not set")
+	}
+
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { client.Close() })
+
+	key := "test:" + time.Now().Format(time.RFC3339Nano)
+	t.Cleanup(func() { client.Del(ctx, "ratelimit:"+key) })
+
+	limiter := NewRedis(client)
+	limit := config.Limit{Requests: 2, Period: time.Hour}
+
+	for range limit.Requests {
+		allowed, _, err := limiter.Allow(ctx, key, limit)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := limiter.Allow(ctx, key, limit)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.InDelta(t, 30*time.Minute, retryAfter, float64(time.Second))
+
+	require.NoError(t, limiter.Refund(ctx, key, limit))
+	allowed, _, err = limiter.Allow(ctx, key, limit)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	ttl, err := client.PTTL(ctx, "ratelimit:"+key).Result()
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour, ttl, float64(time.Second))
+}
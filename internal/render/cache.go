@@ -0,0 +1,47 @@
+package render
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/cache/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// RedisCache shares the rendered html between the replicas, the hot entries
+// are also kept in the local cache of the client.
+type RedisCache struct {
+	cache *cache.Cache
+	ttl   time.Duration
+}
+
+var _ Cache = (*RedisCache)(nil)
+
+func NewRedisCache(cache *cache.Cache, ttl time.Duration) *RedisCache {
+	return &RedisCache{cache: cache, ttl: ttl}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) (string, bool) {
+	var rendered string
+	err := c.cache.Get(ctx, key, &rendered)
+	if err != nil {
+		if err != cache.ErrCacheMiss {
+			log.Ctx(ctx).Warn().Err(err).Msg("Failed get rendered html")
+		}
+		// the text is rendered again
+		return "", false
+	}
+	return rendered, true
+}
+
+func (c *RedisCache) Add(ctx context.Context, key string, rendered string) {
+	err := c.cache.Set(&cache.Item{
+		Ctx:   ctx,
+		Key:   key,
+		Value: rendered,
+		TTL:   c.ttl,
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed save rendered html")
+	}
+}
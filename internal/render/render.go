@@ -0,0 +1,112 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/rs/zerolog/log"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+// version is a part of the cache keys, bump it when the rendering or the
+// policy changes, so the html rendered by the old rules isn't served.
+const version = "1"
+
+var (
+	// raw html in markdown is dropped by goldmark, the policy still checks
+	// everything it produces
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(goldmarkhtml.WithHardWraps()),
+	)
+	policy = newPolicy()
+
+	blankLines = regexp.MustCompile(`\n[ \t]*\n`)
+)
+
+// newPolicy allows the elements of user generated content, links get
+// rel="nofollow" and only the safe url schemes are kept.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	// the task lists of GFM
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// Cache keeps the rendered html by the content version.
+type Cache interface {
+	Get(ctx context.Context, key string) (string, bool)
+	Add(ctx context.Context, key string, html string)
+}
+
+// Renderer renders the texts of the posts and the comments to html, every
+// content version is rendered once while it stays in the cache.
+type Renderer struct {
+	cache Cache
+}
+
+func NewRenderer(cache Cache) *Renderer {
+	return &Renderer{cache: cache}
+}
+
+// HTML returns the sanitized html of the text in the format.
+func (r *Renderer) HTML(ctx context.Context, format model.TextFormat, text string) string {
+	key := Key(format, text)
+	if rendered, ok := r.cache.Get(ctx, key); ok {
+		return rendered
+	}
+
+	rendered := Render(ctx, format, text)
+	r.cache.Add(ctx, key, rendered)
+	return rendered
+}
+
+// Key is the cache key of the content version, the hash of the format and the
+// text, so the edited content never gets the html of the old one.
+func Key(format model.TextFormat, text string) string {
+	sum := sha256.Sum256([]byte(string(format) + "\x00" + text))
+	return "html:" + version + ":" + hex.EncodeToString(sum[:])
+}
+
+// Render renders the text in the format to sanitized html, an unknown format
+// is rendered as plain text.
+func Render(ctx context.Context, format model.TextFormat, text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if format != model.FormatMarkdown {
+		return plain(text)
+	}
+
+	var buf bytes.Buffer
+	err := markdown.Convert([]byte(text), &buf)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Markdown rendering failed, the text is rendered as plain")
+		return plain(text)
+	}
+	return policy.Sanitize(buf.String())
+}
+
+// plain escapes the text, the blank lines separate the paragraphs and the
+// other line breaks are kept.
+func plain(text string) string {
+	var b strings.Builder
+	for _, paragraph := range blankLines.Split(text, -1) {
+		paragraph = strings.Trim(paragraph, "\n")
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
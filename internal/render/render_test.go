@@ -0,0 +1,83 @@
+package render
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name   string
+		format model.TextFormat
+		text   string
+		want   string
+	}{
+		{
+			name:   "Plain text is escaped",
+			format: model.FormatPlain,
+			text:   "<script>alert(1)</script> & **bold**",
+			want:   "<p>&lt;script&gt;alert(1)&lt;/script&gt; &amp; **bold**</p>\n",
+		},
+		{
+			name:   "Plain text keeps paragraphs and line breaks",
+			format: model.FormatPlain,
+			text:   "first\nline\r\n\r\n\n second",
+			want:   "<p>first<br>\nline</p>\n<p> second</p>\n",
+		},
+		{
+			name:   "Unknown format is rendered as plain",
+			format: "",
+			text:   "*text*",
+			want:   "<p>*text*</p>\n",
+		},
+		{
+			name:   "Markdown",
+			format: model.FormatMarkdown,
+			text:   "# Title\n\n**bold** and ~~gone~~ [link](https://example.com)",
+			want:   "<h1>Title</h1>\n<p><strong>bold</strong> and <del>gone</del> <a href=\"https://example.com\" rel=\"nofollow\">link</a></p>\n",
+		},
+		{
+			name:   "Raw html of markdown is dropped",
+			format: model.FormatMarkdown,
+			text:   "<script>alert(1)</script>\n\ntext <img src=x onerror=alert(1)>",
+			want:   "\n<p>text </p>\n",
+		},
+		{
+			name:   "Javascript links are removed",
+			format: model.FormatMarkdown,
+			text:   "[click](javascript:alert(1)) ![img](javascript:alert(1))",
+			want:   "<p>click <img alt=\"img\"></p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Render(context.Background(), tt.format, tt.text))
+		})
+	}
+}
+
+func TestRendererHTML(t *testing.T) {
+	ctx := context.Background()
+	cache := lru.New[string](10)
+	renderer := NewRenderer(cache)
+
+	html := renderer.HTML(ctx, model.FormatMarkdown, "*text*")
+	assert.Equal(t, "<p><em>text</em></p>\n", html)
+
+	cached, ok := cache.Get(ctx, Key(model.FormatMarkdown, "*text*"))
+	assert.True(t, ok)
+	assert.Equal(t, html, cached)
+
+	// the cached version is returned without rendering
+	cache.Add(ctx, Key(model.FormatMarkdown, "*text*"), "<p>cached</p>")
+	assert.Equal(t, "<p>cached</p>", renderer.HTML(ctx, model.FormatMarkdown, "*text*"))
+
+	// the same text in another format is another version
+	assert.Equal(t, "<p>*text*</p>\n", renderer.HTML(ctx, model.FormatPlain, "*text*"))
+	assert.NotEqual(t, Key(model.FormatPlain, "*text*"), Key(model.FormatMarkdown, "*text*"))
+}
@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Publisher publishes the scheduled posts whose time has come.
+type Publisher interface {
+	PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error)
+}
+
+// Run publishes the due posts right away and then every interval until ctx is
+// canceled, onPublished is called for every published post.
+func Run(ctx context.Context, publisher Publisher, interval time.Duration, onPublished func(*model.Post)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		publishDue(ctx, publisher, onPublished)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func publishDue(ctx context.Context, publisher Publisher, onPublished func(*model.Post)) {
+	posts, err := publisher.PublishDuePosts(ctx, time.Now())
+	if err != nil {
+		// the posts stay scheduled and are published on the next tick
+		if ctx.Err() == nil {
+			log.Error().Err(err).Msg("Publishing the scheduled posts failed")
+		}
+		return
+	}
+
+	for _, post := range posts {
+		log.Info().Int64("post_id", post.ID).Msg("Scheduled post published")
+		onPublished(post)
+	}
+}
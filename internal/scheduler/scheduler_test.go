@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type publisherFunc func(ctx context.Context, now time.Time) ([]*model.Post, error)
+
+func (f publisherFunc) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	return f(ctx, now)
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Published posts are passed on until the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var (
+			mu    sync.Mutex
+			calls int
+		)
+		publisher := publisherFunc(func(ctx context.Context, now time.Time) ([]*model.Post, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			switch calls {
+			case 1:
+				return []*model.Post{{ID: 1}, {ID: 2}}, nil
+			case 2:
+				return nil, errors.New("connection refused")
+			case 3:
+				return []*model.Post{{ID: 3}}, nil
+			}
+			return nil, nil
+		})
+
+		published := make(chan int64, 10)
+		done := make(chan struct{})
+		go func() {
+			Run(ctx, publisher, time.Millisecond, func(post *model.Post) {
+				published <- post.ID
+			})
+			close(done)
+		}()
+
+		var ids []int64
+		for len(ids) < 3 {
+			select {
+			case id := <-published:
+				ids = append(ids, id)
+			case <-time.After(time.Second):
+				t.Fatal("the posts weren't published")
+			}
+		}
+		assert.Equal(t, []int64{1, 2, 3}, ids, "an error doesn't stop the scheduler")
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Run didn't return after the context was canceled")
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+
+	"github.com/go-redis/cache/v9"
+	"github.com/nabishec/ozon_habr_api/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// cacheGet reads the key from the cache inside a span; a miss isn't an error
+// for the span.
+func (r *Storage) cacheGet(ctx context.Context, key string, value any) error {
+	ctx, span := tracing.Tracer().Start(ctx, "cache.Get", trace.WithAttributes(
+		attribute.String("cache.key", key),
+	))
+
+	err := r.cache.Get(ctx, key, value)
+
+	span.SetAttributes(attribute.Bool("cache.hit", err == nil))
+	if err == cache.ErrCacheMiss {
+		span.End()
+		return err
+	}
+	tracing.End(span, err)
+	return err
+}
+
+// cacheSet writes the value to the cache with the storage TTL inside a span.
+func (r *Storage) cacheSet(ctx context.Context, key string, value any) error {
+	ctx, span := tracing.Tracer().Start(ctx, "cache.Set", trace.WithAttributes(
+		attribute.String("cache.key", key),
+	))
+
+	err := r.cache.Set(&cache.Item{
+		Ctx:   ctx,
+		Key:   key,
+		Value: value,
+		TTL:   r.cacheTTL,
+	})
+
+	tracing.End(span, err)
+	return err
+}
+
+// cacheDelete removes the key from the cache inside a span.
+func (r *Storage) cacheDelete(ctx context.Context, key string) error {
+	ctx, span := tracing.Tracer().Start(ctx, "cache.Delete", trace.WithAttributes(
+		attribute.String("cache.key", key),
+	))
+
+	err := r.cache.Delete(ctx, key)
+
+	tracing.End(span, err)
+	return err
+}
@@ -14,53 +14,130 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/search"
+	"github.com/nabishec/ozon_habr_api/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CacheObserver is notified about the result of every comments lookup in the cache.
+type CacheObserver interface {
+	ObserveCache(result string)
+}
+
+type noopCacheObserver struct{}
+
+func (noopCacheObserver) ObserveCache(string) {}
+
+const (
+	cacheHit   = "hit"
+	cacheMiss  = "miss"
+	cacheError = "error"
 )
 
 type Storage struct {
 	db *sqlx.DB
 
-	cache *cache.Cache
+	cache         *cache.Cache
+	cacheTTL      time.Duration
+	cacheObserver CacheObserver
 }
 
-func NewStorage(db *sqlx.DB, cache *cache.Cache) *Storage {
+func NewStorage(db *sqlx.DB, cache *cache.Cache, cacheTTL time.Duration) *Storage {
 	return &Storage{
-		db:    db,
-		cache: cache,
+		db:            db,
+		cache:         cache,
+		cacheTTL:      cacheTTL,
+		cacheObserver: noopCacheObserver{},
 	}
 }
 
+func (r *Storage) SetCacheObserver(observer CacheObserver) {
+	r.cacheObserver = observer
+}
+
 func (r *Storage) AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error) {
 	op := "internal.storage.db.AddPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	post := &model.Post{
-		AuthorID:        newPost.AuthorID,
-		Title:           newPost.Title,
-		Text:            newPost.Text,
-		CommentsEnabled: newPost.CommentsEnabled,
-		CreateDate:      time.Now(),
+		AuthorID:         newPost.AuthorID,
+		Title:            newPost.Title,
+		Text:             newPost.Text,
+		CommentsEnabled:  newPost.CommentsEnabled,
+		CreateDate:       newPost.CreateDate,
+		Status:           newPost.Status,
+		PublishAt:        newPost.PublishAt,
+		Format:           newPost.Format,
+		MaxCommentDepth:  newPost.MaxCommentDepth,
+		CommentDepthMode: newPost.CommentDepthMode,
+	}
+	if post.CreateDate.IsZero() {
+		post.CreateDate = time.Now()
+	}
+	if post.Status == model.PostPublished && post.PublishAt == nil {
+		post.PublishAt = &post.CreateDate
 	}
 
-	queryNewPost := `INSERT INTO Posts (author_id, title, text, comments_enabled, create_date)
-							VALUES ($1, $2, $3, $4, $5)
+	queryNewPost := `INSERT INTO Posts (author_id, title, text, comments_enabled, create_date, status, publish_at, format, max_comment_depth, comment_depth_mode)
+							VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 							RETURNING post_id `
 
-	err := r.db.QueryRowContext(ctx, queryNewPost, post.AuthorID, post.Title, post.Text, post.CommentsEnabled, post.CreateDate).Scan(&post.ID)
+	queryNewTags := `INSERT INTO tags (name)
+							SELECT unnest($1::text[])
+							ON CONFLICT (name) DO NOTHING`
 
+	queryTagPost := `INSERT INTO post_tags (post_id, tag_id)
+							SELECT $1, tag_id
+							FROM tags
+							WHERE name = ANY($2)`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
+			}
+		}
+	}()
+
+	err = tx.QueryRowContext(ctx, queryNewPost, post.AuthorID, post.Title, post.Text, post.CommentsEnabled, post.CreateDate, post.Status, post.PublishAt, post.Format,
+		post.MaxCommentDepth, post.CommentDepthMode).Scan(&post.ID)
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	if len(newPost.Tags) > 0 {
+		_, err = tx.ExecContext(ctx, queryNewTags, newPost.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+
+		_, err = tx.ExecContext(ctx, queryTagPost, post.ID, newPost.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, err
 }
 
 func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error) {
 	op := "internal.storage.db.AddComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w", op, err)
@@ -70,7 +147,7 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 
 			errRB := tx.Rollback()
 			if errRB != nil {
-				log.Error().Err(errRB).Msg(" roll back transaction failed")
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
 			}
 
 		}
@@ -81,27 +158,37 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 		PostID:     postID,
 		ParentID:   newComment.ParentID,
 		Text:       newComment.Text,
-		CreateDate: time.Now(),
+		Format:     newComment.Format,
+		CreateDate: newComment.CreateDate,
+	}
+	if comment.CreateDate.IsZero() {
+		comment.CreateDate = time.Now()
 	}
 
-	queryGetCommentEnabledForPost := `SELECT comments_enabled 
+	// only the published posts can be commented
+	queryGetCommentEnabledForPost := `SELECT comments_enabled, max_comment_depth, comment_depth_mode
 								FROM Posts 
-								WHERE post_id = $1`
+								WHERE post_id = $1 AND status = 'published'`
 
 	queryGetParentPath := `SELECT path
 								FROM Comments
 								WHERE comment_id = $1 AND post_id = $2`
 
-	queryNewComment := `INSERT INTO Comments (author_id, post_id, parent_id, path, replies_level, text, create_date)
-							VALUES ($1, $2, $3, $4, $5, $6, $7)
+	queryNewComment := `INSERT INTO Comments (author_id, post_id, parent_id, path, replies_level, text, format, create_date)
+							VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 							RETURNING comment_id `
 
 	queryUpdateCommentPath := `UPDATE Comments
 								SET path = $1, replies_level = $2
 								WHERE comment_id = $3`
 
-	var commentEnabled bool
-	err = tx.GetContext(ctx, &commentEnabled, queryGetCommentEnabledForPost, postID)
+	queryUpdatePostStats := `UPDATE Posts
+								SET comments_count = comments_count + 1,
+								last_comment_at = GREATEST(last_comment_at, $1)
+								WHERE post_id = $2`
+
+	var post model.Post
+	err = tx.GetContext(ctx, &post, queryGetCommentEnabledForPost, postID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, errs.ErrPostNotExist
@@ -109,7 +196,7 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	if commentEnabled == false {
+	if post.CommentsEnabled == false {
 		return nil, errs.ErrCommentsNotEnabled
 	}
 
@@ -122,10 +209,23 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 			}
 			return nil, fmt.Errorf("%s:%w", op, err)
 		}
-		parentPath += "."
+
+		var ok bool
+		parentPath, ok = post.CommentDepth(newComment.Depth).ReplyParent(parentPath)
+		if !ok {
+			err = errs.ErrCommentTooDeep
+			return nil, err
+		}
+		comment.ParentID, err = model.PathCommentID(parentPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+		if parentPath != "" {
+			parentPath += "."
+		}
 	}
 	// we specify 0 as the path and 1 as rep. level, because we know that the comment ID cannot be zero, and the query did not return errors due to not null
-	err = tx.QueryRowContext(ctx, queryNewComment, comment.AuthorID, comment.PostID, comment.ParentID, "0", 1, comment.Text, comment.CreateDate).Scan(&comment.ID)
+	err = tx.QueryRowContext(ctx, queryNewComment, comment.AuthorID, comment.PostID, comment.ParentID, "0", 1, comment.Text, comment.Format, comment.CreateDate).Scan(&comment.ID)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w", op, err)
@@ -139,6 +239,11 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
+	_, err = tx.ExecContext(ctx, queryUpdatePostStats, comment.CreateDate, comment.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, fmt.Errorf("%s:%w", op, err)
@@ -146,60 +251,55 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 
 	comment.Path = path
 
-	if parentPath != "" {
-		parentPath = parentPath[:len(parentPath)-1]
-	}
-
-	commentsBranch, err := r.getCommentsToPostFromCashe(ctx, comment.PostID, parentPath)
-	if err == nil {
-		commentsBranch = append(commentsBranch, comment)
-		err = r.setCommentsBranchToPostInCache(ctx, commentsBranch, comment.PostID, parentPath)
-		if err != nil {
-			log.Warn().Err(err).Msg("cache returned error")
-			err = nil
-		}
-	} else {
-		if err != cache.ErrCacheMiss {
-			log.Warn().Err(err).Msg("cache returned error") // logging cache error and return nil error because it is not critical
-		}
+	// the new comment changes its branch and the counts of all its ancestors
+	err = r.deleteCommentsOfPostFromCache(ctx, comment.PostID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("cache returned error") // logging cache error and return nil error because it is not critical
 		err = nil
 	}
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return comment, nil
 }
 
 func (r *Storage) setCommentsBranchToPostInCache(ctx context.Context, commentsBranch []*model.Comment, postID int64, path string) error {
 	op := "internal.storage.db.SetCommentsBranchToPostInCache()"
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	var err error
 	if path == "" {
-		err = r.cache.Set(&cache.Item{
-			Ctx:   ctx,
-			Key:   "post:" + strconv.FormatInt(postID, 10),
-			Value: commentsBranch,
-			TTL:   30 * time.Minute,
-		})
+		err = r.cacheSet(ctx, "post:"+strconv.FormatInt(postID, 10), commentsBranch)
 	} else {
-		err = r.cache.Set(&cache.Item{
-			Ctx:   ctx,
-			Key:   "comments:" + path,
-			Value: commentsBranch,
-			TTL:   30 * time.Minute,
-		})
+		err = r.cacheSet(ctx, "comments:"+path, commentsBranch)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return nil
+}
+
+// deleteCommentsOfPostFromCache drops the cached comments of the post. The
+// branches are read through the root one, so deleting it drops them all and the
+// next read loads the whole tree again.
+func (r *Storage) deleteCommentsOfPostFromCache(ctx context.Context, postID int64) error {
+	op := "internal.storage.db.DeleteCommentsOfPostFromCache()"
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	err := r.cacheDelete(ctx, "post:"+strconv.FormatInt(postID, 10))
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return nil
 }
+
 func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
 	op := "internal.storage.db.UpdateEnableCommentToPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -209,7 +309,7 @@ func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, a
 		if err != nil && err != errs.ErrPostNotExist && err != errs.ErrUnauthorizedAccess {
 			errRB := tx.Rollback()
 			if errRB != nil {
-				log.Error().Err(errRB).Msg(" roll back transaction failed")
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
 			}
 		}
 	}()
@@ -218,7 +318,7 @@ func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, a
 		ID: postID,
 	}
 
-	queryGetPost := `SELECT author_id, title, text, comments_enabled, create_date
+	queryGetPost := `SELECT author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
 							FROM Posts
 							WHERE post_id = $1 `
 
@@ -235,8 +335,12 @@ func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, a
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
+	// the post of another author doesn't exist for the user unless it is published
 	if post.AuthorID != authorID {
-		return nil, errs.ErrUnauthorizedAccess
+		if post.Status == model.PostPublished {
+			return nil, errs.ErrUnauthorizedAccess
+		}
+		return nil, errs.ErrPostNotExist
 	}
 
 	_, err = tx.ExecContext(ctx, queryUpdatePost, commentsEnabled, postID)
@@ -251,21 +355,386 @@ func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, a
 
 	post.CommentsEnabled = commentsEnabled
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, nil
 }
 
-func (r *Storage) GetAllPosts(ctx context.Context) ([]*model.Post, error) {
+func (r *Storage) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	op := "internal.storage.db.PublishPost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.setPostStatus(ctx, postID, authorID, model.PostPublished, time.Now())
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrUnauthorizedAccess || err == errs.ErrPostAlreadyPublished {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+func (r *Storage) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	op := "internal.storage.db.SchedulePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.setPostStatus(ctx, postID, authorID, model.PostScheduled, publishAt)
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrUnauthorizedAccess || err == errs.ErrPostAlreadyPublished {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// setPostStatus moves the unpublished post of the author to the status, the
+// row lock keeps the scheduler from publishing the post in between.
+func (r *Storage) setPostStatus(ctx context.Context, postID int64, authorID uuid.UUID, status model.PostStatus, publishAt time.Time) (*model.Post, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
+			}
+		}
+	}()
+
+	queryLockPost := `SELECT author_id, status
+							FROM Posts
+							WHERE post_id = $1
+							FOR UPDATE`
+
+	queryUpdateStatus := `UPDATE Posts
+							SET status = $1, publish_at = $2
+							WHERE post_id = $3
+							RETURNING post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode`
+
+	var locked struct {
+		AuthorID uuid.UUID        `db:"author_id"`
+		Status   model.PostStatus `db:"status"`
+	}
+	err = tx.GetContext(ctx, &locked, queryLockPost, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = errs.ErrPostNotExist
+		}
+		return nil, err
+	}
+
+	// the post of another author doesn't exist for the user unless it is published
+	if locked.AuthorID != authorID {
+		err = errs.ErrPostNotExist
+		if locked.Status == model.PostPublished {
+			err = errs.ErrUnauthorizedAccess
+		}
+		return nil, err
+	}
+	if locked.Status == model.PostPublished {
+		err = errs.ErrPostAlreadyPublished
+		return nil, err
+	}
+
+	var post = new(model.Post)
+	err = tx.GetContext(ctx, post, queryUpdateStatus, status, publishAt, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// PublishDuePosts publishes the scheduled posts whose time has come by now and
+// returns them, every post is returned by one call only, even when several
+// replicas run the scheduler.
+func (r *Storage) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	op := "internal.storage.db.PublishDuePosts()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	queryPublishDuePosts := `WITH published AS (
+								UPDATE Posts
+								SET status = 'published'
+								WHERE status = 'scheduled' AND publish_at <= $1
+								RETURNING post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
+							)
+							SELECT *
+							FROM published
+							ORDER BY publish_at, post_id`
+
+	var posts []*model.Post
+	err := r.db.SelectContext(ctx, &posts, queryPublishDuePosts, now)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return posts, nil
+}
+
+func (r *Storage) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	op := "internal.storage.db.VotePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
+			}
+		}
+	}()
+
+	// the post row lock serializes the votes to the post, so the counters
+	// always match the post_votes rows, only the published posts can be voted for
+	queryLockPost := `SELECT post_id
+							FROM Posts
+							WHERE post_id = $1 AND status = 'published'
+							FOR UPDATE`
+
+	queryGetVote := `SELECT value
+							FROM post_votes
+							WHERE post_id = $1 AND user_id = $2`
+
+	queryUpsertVote := `INSERT INTO post_votes (post_id, user_id, value, create_date)
+							VALUES ($1, $2, $3, $4)
+							ON CONFLICT (post_id, user_id) DO UPDATE
+							SET value = EXCLUDED.value, create_date = EXCLUDED.create_date`
+
+	queryDeleteVote := `DELETE FROM post_votes
+							WHERE post_id = $1 AND user_id = $2`
+
+	queryUpdatePostScore := `UPDATE Posts
+								SET upvotes = upvotes + $1, downvotes = downvotes + $2, score = score + $3
+								WHERE post_id = $4
+								RETURNING post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode`
+
+	var lockedID int64
+	err = tx.GetContext(ctx, &lockedID, queryLockPost, postID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = errs.ErrPostNotExist
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	var old model.VoteValue
+	err = tx.GetContext(ctx, &old, queryGetVote, postID, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	if value == model.VoteNone {
+		_, err = tx.ExecContext(ctx, queryDeleteVote, postID, userID)
+	} else {
+		_, err = tx.ExecContext(ctx, queryUpsertVote, postID, userID, value, time.Now())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	up, down, score := model.VoteDelta(old, value)
+
+	var post = new(model.Post)
+	err = tx.GetContext(ctx, post, queryUpdatePostScore, up, down, score, postID)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// GetPostVotes returns the votes of the user for the posts, posts the user
+// didn't vote for are absent from the result.
+func (r *Storage) GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error) {
+	op := "internal.storage.db.GetPostVotes()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var rows []struct {
+		PostID int64           `db:"post_id"`
+		Value  model.VoteValue `db:"value"`
+	}
+
+	queryGetPostVotes := `SELECT post_id, value
+							FROM post_votes
+							WHERE user_id = $1 AND post_id = ANY($2)`
+
+	err := r.db.SelectContext(ctx, &rows, queryGetPostVotes, userID, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	votes := make(map[int64]model.VoteValue, len(rows))
+	for _, v := range rows {
+		votes[v.PostID] = v.Value
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return votes, nil
+}
+
+func (r *Storage) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	op := "internal.storage.db.VoteComment()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Ctx(ctx).Error().Err(errRB).Msg(" roll back transaction failed")
+			}
+		}
+	}()
+
+	queryLockComment := `SELECT comment_id
+							FROM Comments
+							WHERE comment_id = $1
+							FOR UPDATE`
+
+	queryGetVote := `SELECT value
+							FROM comment_votes
+							WHERE comment_id = $1 AND user_id = $2`
+
+	queryUpsertVote := `INSERT INTO comment_votes (comment_id, user_id, value, create_date)
+							VALUES ($1, $2, $3, $4)
+							ON CONFLICT (comment_id, user_id) DO UPDATE
+							SET value = EXCLUDED.value, create_date = EXCLUDED.create_date`
+
+	queryDeleteVote := `DELETE FROM comment_votes
+							WHERE comment_id = $1 AND user_id = $2`
+
+	queryUpdateCommentScore := `WITH c AS (
+									UPDATE Comments
+									SET score = score + $1
+									WHERE comment_id = $2
+									RETURNING comment_id, author_id, post_id, parent_id, path, text, format, create_date, score
+								)
+								SELECT c.*, counts.*
+								FROM c
+								` + commentCounts
+
+	var lockedID int64
+	err = tx.GetContext(ctx, &lockedID, queryLockComment, commentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = errs.ErrCommentNotExist
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	var old model.VoteValue
+	err = tx.GetContext(ctx, &old, queryGetVote, commentID, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	if value == model.VoteNone {
+		_, err = tx.ExecContext(ctx, queryDeleteVote, commentID, userID)
+	} else {
+		_, err = tx.ExecContext(ctx, queryUpsertVote, commentID, userID, value, time.Now())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	_, _, score := model.VoteDelta(old, value)
+
+	var comment = new(model.Comment)
+	err = tx.GetContext(ctx, comment, queryUpdateCommentScore, score, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	err = r.deleteCommentsOfPostFromCache(ctx, comment.PostID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("cache returned error")
+		err = nil
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return comment, nil
+}
+
+// GetCommentVotes returns the votes of the user for the comments, comments the
+// user didn't vote for are absent from the result.
+func (r *Storage) GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error) {
+	op := "internal.storage.db.GetCommentVotes()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var rows []struct {
+		CommentID int64           `db:"comment_id"`
+		Value     model.VoteValue `db:"value"`
+	}
+
+	queryGetCommentVotes := `SELECT comment_id, value
+							FROM comment_votes
+							WHERE user_id = $1 AND comment_id = ANY($2)`
+
+	err := r.db.SelectContext(ctx, &rows, queryGetCommentVotes, userID, commentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	votes := make(map[int64]model.VoteValue, len(rows))
+	for _, v := range rows {
+		votes[v.CommentID] = v.Value
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return votes, nil
+}
+
+func (r *Storage) GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error) {
 	op := "internal.storage.db.GetAllPosts()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	queryGetAllPosts := `SELECT post_id, author_id, title, text, comments_enabled, create_date
+	where, args := postsWhere(opts)
+	queryGetAllPosts := `SELECT post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
 							FROM Posts
-							ORDER BY create_date`
+							` + where + `
+							ORDER BY ` + postsOrder(opts)
 
 	var posts []*model.Post
-	err := r.db.SelectContext(ctx, &posts, queryGetAllPosts)
+	err := r.db.SelectContext(ctx, &posts, queryGetAllPosts, args...)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -274,17 +743,96 @@ func (r *Storage) GetAllPosts(ctx context.Context) ([]*model.Post, error) {
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
 	return posts, nil
 }
 
+func (r *Storage) GetAllPostsUnfiltered(ctx context.Context) ([]*model.Post, error) {
+	op := "internal.storage.db.GetAllPostsUnfiltered()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	queryGetAllPosts := `SELECT post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
+							FROM Posts
+							ORDER BY post_id`
+
+	var posts []*model.Post
+	err := r.db.SelectContext(ctx, &posts, queryGetAllPosts)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return posts, nil
+}
+
+// postsWhere returns the WHERE clause selecting the posts of the options and
+// its arguments, the author and the dates are served by posts_author_create_date_idx
+// and posts_create_date_idx.
+func postsWhere(opts model.PostsOptions) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if opts.ViewerID != uuid.Nil {
+		add("(status = 'published' OR author_id = $%d)", opts.ViewerID)
+	} else {
+		conditions = append(conditions, "status = 'published'")
+	}
+
+	if opts.Tag != "" {
+		add(`post_id IN (
+								SELECT post_id
+								FROM post_tags
+								JOIN tags USING (tag_id)
+								WHERE name = $%d
+							)`, opts.Tag)
+	}
+	if opts.Filter.AuthorID != nil {
+		add("author_id = $%d", *opts.Filter.AuthorID)
+	}
+	if opts.Filter.CreatedAfter != nil {
+		add("create_date > $%d", *opts.Filter.CreatedAfter)
+	}
+	if opts.Filter.CreatedBefore != nil {
+		add("create_date < $%d", *opts.Filter.CreatedBefore)
+	}
+	if opts.Filter.CommentsEnabled != nil {
+		add("comments_enabled = $%d", *opts.Filter.CommentsEnabled)
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// postsOrder returns the ORDER BY clause of the options, only the known
+// columns get into the query.
+func postsOrder(opts model.PostsOptions) string {
+	column := "create_date"
+	switch opts.OrderBy {
+	case model.PostOrderCommentsCount, model.PostOrderLastCommentAt, model.PostOrderScore:
+		column = string(opts.OrderBy)
+	}
+
+	direction := "ASC"
+	if opts.Desc {
+		direction = "DESC"
+	}
+	// posts without comments have no last_comment_at and go last either way
+	return column + " " + direction + " NULLS LAST, post_id " + direction
+}
+
 func (r *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error) {
 	op := "internal.storage.db.GetPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
-	queryGetPost := `SELECT post_id, author_id, title, text, comments_enabled, create_date
+	queryGetPost := `SELECT post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
 							FROM Posts
 							WHERE post_id = $1`
 
@@ -298,7 +846,7 @@ func (r *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error
 		return nil, fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
 	return post, nil
 }
@@ -306,21 +854,21 @@ func (r *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error
 func (r *Storage) GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
 	op := "internal.storage.db.GetCommentsBranch()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	allComments, err := r.getCommentsToPostFromCashe(ctx, postID, path)
 	if err != nil {
 		if err == errs.ErrPathNotExist {
 			return nil, err
 		}
-		log.Warn().Err(err).Msg("Cache returned error")
+		log.Ctx(ctx).Warn().Err(err).Msg("Cache returned error")
 	} else {
 		return allComments, nil
 	}
 
 	allComments = make([]*model.Comment, 0)
 
-	queryGetCommentsToPost := `SELECT comment_id, author_id, post_id, parent_id, path, text, create_date
+	queryGetCommentsToPost := `SELECT comment_id, author_id, post_id, parent_id, path, text, format, create_date, score
 									FROM Comments
 									WHERE post_id = $1
 									ORDER BY string_to_array(path::text, '.')::int[],
@@ -335,91 +883,213 @@ func (r *Storage) GetCommentsBranch(ctx context.Context, postID int64, path stri
 		return nil, errs.ErrCommentsNotExist
 	}
 
-	commentsMap, rootComments := createCommentMap(allComments)
+	commentsMap, rootComments := createCommentMap(ctx, allComments)
 
 	err = r.setCommentsToPostInCache(ctx, commentsMap, rootComments, postID)
 	if err != nil {
-		log.Warn().Err(err).Msg("Cache returned error")
+		log.Ctx(ctx).Warn().Err(err).Msg("Cache returned error")
 	}
 
 	if path == "" {
-		log.Debug().Msgf("%s end", op)
+		log.Ctx(ctx).Debug().Msgf("%s end", op)
 		return rootComments, nil
 	} else {
 		if v, ok := commentsMap[path]; ok != true {
 			return nil, errs.ErrPathNotExist
 		} else {
-			log.Debug().Msgf("%s end", op)
+			log.Ctx(ctx).Debug().Msgf("%s end", op)
 			return v, nil
 		}
 	}
 
 }
 
-func createCommentMap(allComments []*model.Comment) (map[string][]*model.Comment, []*model.Comment) {
+// GetCommentsBranches returns the branches of the keys, a key is absent from
+// the result when there is no such branch. Branches missed in the cache are
+// loaded with one query for all their posts.
+func (r *Storage) GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error) {
+	op := "internal.storage.db.GetCommentsBranches()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	branches := make(map[model.BranchKey][]*model.Comment, len(keys))
+	var missed []model.BranchKey
+	var postIDs []int64
+	seen := make(map[int64]bool)
+
+	for _, key := range keys {
+		comments, err := r.getCommentsToPostFromCashe(ctx, key.PostID, key.Path)
+		if err == nil {
+			branches[key] = comments
+			continue
+		}
+		if err == errs.ErrPathNotExist {
+			continue
+		}
+		if err != cache.ErrCacheMiss && err != errs.ErrPostNotCached {
+			log.Ctx(ctx).Warn().Err(err).Msg("Cache returned error")
+		}
+
+		missed = append(missed, key)
+		if !seen[key.PostID] {
+			seen[key.PostID] = true
+			postIDs = append(postIDs, key.PostID)
+		}
+	}
+
+	if len(missed) == 0 {
+		log.Ctx(ctx).Debug().Msgf("%s end", op)
+		return branches, nil
+	}
+
+	allComments := make([]*model.Comment, 0)
+
+	queryGetCommentsToPosts := `SELECT comment_id, author_id, post_id, parent_id, path, text, format, create_date, score
+									FROM Comments
+									WHERE post_id = ANY($1)
+									ORDER BY post_id,
+									string_to_array(path::text, '.')::int[],
+									create_date DESC`
+
+	err := r.db.SelectContext(ctx, &allComments, queryGetCommentsToPosts, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	commentsByPost := make(map[int64][]*model.Comment)
+	for _, v := range allComments {
+		commentsByPost[v.PostID] = append(commentsByPost[v.PostID], v)
+	}
+
+	type tree struct {
+		commentsMap  map[string][]*model.Comment
+		rootComments []*model.Comment
+	}
+	trees := make(map[int64]tree, len(commentsByPost))
+	for postID, comments := range commentsByPost {
+		commentsMap, rootComments := createCommentMap(ctx, comments)
+		trees[postID] = tree{commentsMap: commentsMap, rootComments: rootComments}
+
+		err = r.setCommentsToPostInCache(ctx, commentsMap, rootComments, postID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("Cache returned error")
+		}
+	}
+
+	for _, key := range missed {
+		t, ok := trees[key.PostID]
+		if !ok {
+			continue
+		}
+		if key.Path == "" {
+			branches[key] = t.rootComments
+			continue
+		}
+		if v, ok := t.commentsMap[key.Path]; ok {
+			branches[key] = v
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return branches, nil
+}
+
+// commentCounts joins the counts of the replies and of all the descendants of
+// the comment c, they are found with the index of the paths.
+const commentCounts = `CROSS JOIN LATERAL (
+								SELECT count(*) FILTER (WHERE nlevel(d.path) = nlevel(c.path) + 1) AS replies_count,
+									count(*) AS descendants_count
+								FROM Comments d
+								WHERE d.path <@ c.path AND d.path <> c.path
+							) counts`
+
+func createCommentMap(ctx context.Context, allComments []*model.Comment) (map[string][]*model.Comment, []*model.Comment) {
 
 	var rootComments []*model.Comment
 	var commentsMap = make(map[string][]*model.Comment)
-	var pathMap = make(map[int64]string, len(allComments))
+	var commentByID = make(map[int64]*model.Comment, len(allComments))
 	for _, v := range allComments {
-		pathMap[v.ID] = v.Path
+		commentByID[v.ID] = v
 		if v.ParentID == nil {
 			rootComments = append(rootComments, v)
 		} else {
+			parent := commentByID[*v.ParentID] //we know that parent comment exists because of the query is ordered by path
+			commentsMap[parent.Path] = append(commentsMap[parent.Path], v)
+			parent.RepliesCount++
+		}
 
-			commentsMap[pathMap[*v.ParentID]] = append(commentsMap[pathMap[*v.ParentID]], v) //we know that parent comment exists because of the query is ordered by path
+		for _, id := range v.AncestorIDs() {
+			if ancestor, ok := commentByID[id]; ok {
+				ancestor.DescendantsCount++
+			}
 		}
 	}
 
-	log.Debug().Msg("Comment map created successfully")
+	log.Ctx(ctx).Debug().Msg("Comment map created successfully")
 	return commentsMap, rootComments
 }
 
 func (r *Storage) getCommentsToPostFromCashe(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
 	op := "internal.storage.db.GetCommentsToPostFromCashe()"
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	var rootComments []*model.Comment
-	err := r.cache.Get(ctx, "post:"+strconv.FormatInt(postID, 10), &rootComments)
+	err := r.cacheGet(ctx, "post:"+strconv.FormatInt(postID, 10), &rootComments)
 	if err != nil {
 		if err == cache.ErrCacheMiss {
+			r.cacheObserver.ObserveCache(cacheMiss)
 			return nil, err
 		}
+		r.cacheObserver.ObserveCache(cacheError)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if len(rootComments) == 0 {
+		r.cacheObserver.ObserveCache(cacheMiss)
 		return nil, errs.ErrPostNotCached
 	}
 
 	if path == "" {
+		r.cacheObserver.ObserveCache(cacheHit)
 
-		log.Debug().Msgf("%s end", op)
+		log.Ctx(ctx).Debug().Msgf("%s end", op)
 		return rootComments, nil
 	}
 
 	var commentsBranch []*model.Comment
 
-	err = r.cache.Get(ctx, "comments:"+path, &commentsBranch)
+	err = r.cacheGet(ctx, "comments:"+path, &commentsBranch)
 	if err != nil {
 		if err == cache.ErrCacheMiss && len(rootComments) > 0 {
+			r.cacheObserver.ObserveCache(cacheHit)
 			return nil, nil // it work when try get replies of comment without replies
 		}
+		r.cacheObserver.ObserveCache(cacheError)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if len(commentsBranch) == 0 {
+		r.cacheObserver.ObserveCache(cacheMiss)
 		return nil, errs.ErrPathNotExist
 	}
 
-	log.Debug().Msgf("%s end", op)
+	r.cacheObserver.ObserveCache(cacheHit)
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return commentsBranch, nil
 
 }
 
 func (r *Storage) setCommentsToPostInCache(ctx context.Context, commentsMap map[string][]*model.Comment, rootComments []*model.Comment, postID int64) error {
 	op := "internal.storage.db.SetCommentToPostInCache()"
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	// the span groups the concurrent writes of the branches below
+	ctx, span := tracing.Tracer().Start(ctx, "cache.SetCommentsToPost", trace.WithAttributes(
+		attribute.Int64("post.id", postID),
+		attribute.Int("cache.branches", len(commentsMap)),
+	))
+	defer span.End()
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -430,12 +1100,7 @@ func (r *Storage) setCommentsToPostInCache(ctx context.Context, commentsMap map[
 		go func(path string, comments []*model.Comment) {
 			defer wg.Done()
 
-			err := r.cache.Set(&cache.Item{
-				Ctx:   ctx,
-				Key:   "comments:" + path,
-				Value: comments,
-				TTL:   30 * time.Minute,
-			})
+			err := r.cacheSet(ctx, "comments:"+path, comments)
 
 			mu.Lock()
 			defer mu.Unlock()
@@ -451,25 +1116,20 @@ func (r *Storage) setCommentsToPostInCache(ctx context.Context, commentsMap map[
 		return fmt.Errorf("%s: %w", op, firstErr)
 	}
 
-	err := r.cache.Set(&cache.Item{
-		Ctx:   ctx,
-		Key:   "post:" + strconv.FormatInt(postID, 10),
-		Value: rootComments,
-		TTL:   30 * time.Minute,
-	})
+	err := r.cacheSet(ctx, "post:"+strconv.FormatInt(postID, 10), rootComments)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return nil
 }
 
 func (r *Storage) GetCommentPath(ctx context.Context, commentID int64) (string, error) {
 	op := "internal.storage.db.GetCommentPath()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	var path string
 
@@ -486,6 +1146,451 @@ func (r *Storage) GetCommentPath(ctx context.Context, commentID int64) (string,
 		return "", fmt.Errorf("%s:%w", op, err)
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return path, nil
 }
+
+// GetCommentPaths returns the paths of the comments, a comment is absent from
+// the result when it doesn't exist.
+func (r *Storage) GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+	op := "internal.storage.db.GetCommentPaths()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var rows []struct {
+		ID   int64  `db:"comment_id"`
+		Path string `db:"path"`
+	}
+
+	queryGetCommentPaths := `SELECT comment_id, path
+								FROM Comments
+								WHERE comment_id = ANY($1)`
+
+	err := r.db.SelectContext(ctx, &rows, queryGetCommentPaths, commentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	paths := make(map[int64]string, len(rows))
+	for _, v := range rows {
+		paths[v.ID] = v.Path
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return paths, nil
+}
+
+// reactionTable returns the table of the reactions to the target and its column
+// referencing the target.
+func reactionTable(target model.ReactionTarget) (table, column string) {
+	if target == model.ReactionTargetComment {
+		return "comment_reactions", "comment_id"
+	}
+	return "post_reactions", "post_id"
+}
+
+// reactionPostID checks that the target exists and returns the post it belongs
+// to, only the published posts can get reactions.
+func (r *Storage) reactionPostID(ctx context.Context, key model.ReactionKey) (int64, error) {
+	query := `SELECT post_id
+				FROM Posts
+				WHERE post_id = $1 AND status = 'published'`
+	errNotExist := errs.ErrPostNotExist
+	if key.Target == model.ReactionTargetComment {
+		query = `SELECT post_id
+				FROM Comments
+				WHERE comment_id = $1`
+		errNotExist = errs.ErrCommentNotExist
+	}
+
+	var postID int64
+	err := r.db.GetContext(ctx, &postID, query, key.ID)
+	if err == sql.ErrNoRows {
+		return 0, errNotExist
+	}
+	return postID, err
+}
+
+func (r *Storage) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.storage.db.AddReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	postID, err := r.reactionPostID(ctx, key)
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrCommentNotExist {
+			return 0, false, err
+		}
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	table, column := reactionTable(key.Target)
+	queryAddReaction := `INSERT INTO ` + table + ` (` + column + `, user_id, emoji, create_date)
+							VALUES ($1, $2, $3, $4)
+							ON CONFLICT DO NOTHING`
+
+	res, err := r.db.ExecContext(ctx, queryAddReaction, key.ID, userID, emoji, time.Now())
+	if err != nil {
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, affected > 0, nil
+}
+
+func (r *Storage) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.storage.db.RemoveReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	postID, err := r.reactionPostID(ctx, key)
+	if err != nil {
+		if err == errs.ErrPostNotExist || err == errs.ErrCommentNotExist {
+			return 0, false, err
+		}
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	table, column := reactionTable(key.Target)
+	queryRemoveReaction := `DELETE FROM ` + table + `
+							WHERE ` + column + ` = $1 AND user_id = $2 AND emoji = $3`
+
+	res, err := r.db.ExecContext(ctx, queryRemoveReaction, key.ID, userID, emoji)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, false, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, affected > 0, nil
+}
+
+// GetReactions returns the reactions to the posts and the comments of the keys,
+// the most popular first. ReactedByMe is set for the reactions of the user,
+// uuid.Nil matches nobody.
+func (r *Storage) GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+	op := "internal.storage.db.GetReactions()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	idsByTarget := make(map[model.ReactionTarget][]int64)
+	for _, key := range keys {
+		idsByTarget[key.Target] = append(idsByTarget[key.Target], key.ID)
+	}
+
+	reactions := make(map[model.ReactionKey][]model.Reaction, len(keys))
+	for target, ids := range idsByTarget {
+		table, column := reactionTable(target)
+		queryGetReactions := `SELECT ` + column + ` AS id, emoji, COUNT(*) AS count, bool_or(user_id = $2) AS reacted_by_me
+								FROM ` + table + `
+								WHERE ` + column + ` = ANY($1)
+								GROUP BY ` + column + `, emoji
+								ORDER BY count DESC, emoji`
+
+		var rows []struct {
+			ID int64 `db:"id"`
+			model.Reaction
+		}
+		err := r.db.SelectContext(ctx, &rows, queryGetReactions, ids, userID)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+
+		for _, v := range rows {
+			key := model.ReactionKey{Target: target, ID: v.ID}
+			reactions[key] = append(reactions[key], v.Reaction)
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return reactions, nil
+}
+
+// snippetOptions mark the matches the way search.Highlight expects.
+const snippetOptions = "StartSel=" + search.StartSel + ", StopSel=" + search.StopSel + ", MinWords=10, MaxWords=30, MaxFragments=1"
+
+// snippetMarkers are search.StartSel and search.StopSel in SQL, they are
+// removed from the texts before the matches are marked.
+const snippetMarkers = "chr(2) || chr(3)"
+
+// pickSnippet returns the snippet of the stemmer that found the matches, the
+// text is highlighted by both of them because the content is mixed.
+func pickSnippet(russian, english string) string {
+	if strings.Contains(russian, search.StartSel) {
+		return search.Highlight(russian)
+	}
+	return search.Highlight(english)
+}
+
+// Search looks the query up in the search_vector columns, the query is parsed
+// by websearch_to_tsquery with both stemmers.
+func (r *Storage) Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	op := "internal.storage.db.Search()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var (
+		hits []*model.SearchHit
+		err  error
+	)
+	if opts.Type == model.SearchComments {
+		hits, err = r.searchComments(ctx, opts)
+	} else {
+		hits, err = r.searchPosts(ctx, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return hits, nil
+}
+
+func (r *Storage) searchPosts(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	querySearchPosts := `WITH q AS (
+								SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+							), hits AS (
+								SELECT post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode,
+									ts_rank(search_vector, q.query) AS rank
+								FROM Posts, q
+								WHERE search_vector @@ q.query AND status = 'published'
+								ORDER BY rank DESC, post_id DESC
+								LIMIT $2 OFFSET $3
+							)
+							SELECT hits.*,
+								ts_headline('russian', translate(title || ' ' || text, ` + snippetMarkers + `, ''), q.query, $4) AS snippet_russian,
+								ts_headline('english', translate(title || ' ' || text, ` + snippetMarkers + `, ''), q.query, $4) AS snippet_english
+							FROM hits, q
+							ORDER BY rank DESC, post_id DESC`
+
+	var rows []struct {
+		model.Post
+		Rank           float64 `db:"rank"`
+		SnippetRussian string  `db:"snippet_russian"`
+		SnippetEnglish string  `db:"snippet_english"`
+	}
+	err := r.db.SelectContext(ctx, &rows, querySearchPosts, opts.Query, opts.Limit, opts.Offset, snippetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*model.SearchHit, len(rows))
+	for i := range rows {
+		hits[i] = &model.SearchHit{
+			Post:    &rows[i].Post,
+			Rank:    rows[i].Rank,
+			Snippet: pickSnippet(rows[i].SnippetRussian, rows[i].SnippetEnglish),
+		}
+	}
+	return hits, nil
+}
+
+func (r *Storage) searchComments(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	querySearchComments := `WITH q AS (
+								SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+							), hits AS (
+								SELECT comment_id, author_id, post_id, parent_id, path, text, format, create_date, score,
+									ts_rank(search_vector, q.query) AS rank
+								FROM Comments, q
+								WHERE search_vector @@ q.query
+								ORDER BY rank DESC, comment_id DESC
+								LIMIT $2 OFFSET $3
+							)
+							SELECT c.*, counts.*,
+								ts_headline('russian', translate(c.text, ` + snippetMarkers + `, ''), q.query, $4) AS snippet_russian,
+								ts_headline('english', translate(c.text, ` + snippetMarkers + `, ''), q.query, $4) AS snippet_english
+							FROM hits c
+							` + commentCounts + `
+							CROSS JOIN q
+							ORDER BY c.rank DESC, c.comment_id DESC`
+
+	var rows []struct {
+		model.Comment
+		Rank           float64 `db:"rank"`
+		SnippetRussian string  `db:"snippet_russian"`
+		SnippetEnglish string  `db:"snippet_english"`
+	}
+	err := r.db.SelectContext(ctx, &rows, querySearchComments, opts.Query, opts.Limit, opts.Offset, snippetOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*model.SearchHit, len(rows))
+	for i := range rows {
+		hits[i] = &model.SearchHit{
+			Comment: &rows[i].Comment,
+			Rank:    rows[i].Rank,
+			Snippet: pickSnippet(rows[i].SnippetRussian, rows[i].SnippetEnglish),
+		}
+	}
+	return hits, nil
+}
+
+func (r *Storage) GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error) {
+	op := "internal.storage.db.GetPostTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	var rows []struct {
+		PostID int64  `db:"post_id"`
+		Name   string `db:"name"`
+	}
+
+	queryGetPostTags := `SELECT post_id, name
+							FROM post_tags
+							JOIN tags USING (tag_id)
+							WHERE post_id = ANY($1)
+							ORDER BY name`
+
+	err := r.db.SelectContext(ctx, &rows, queryGetPostTags, postIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	tags := make(map[int64][]string, len(postIDs))
+	for _, v := range rows {
+		tags[v.PostID] = append(tags[v.PostID], v.Name)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+// GetTags returns the tags of the published posts, the most used first.
+func (r *Storage) GetTags(ctx context.Context) ([]model.Tag, error) {
+	op := "internal.storage.db.GetTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	queryGetTags := `SELECT name, COUNT(*) AS posts_count
+							FROM tags
+							JOIN post_tags USING (tag_id)
+							JOIN Posts USING (post_id)
+							WHERE status = 'published'
+							GROUP BY name
+							ORDER BY posts_count DESC, name`
+
+	var tags []model.Tag
+	err := r.db.SelectContext(ctx, &tags, queryGetTags)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+// GetUserActivity returns up to limit posts and comments of the author, the
+// newest first, starting after the item of the key when it is given, the
+// drafts and the scheduled posts are included only when unpublished is set.
+// The keys of the page are selected first, both parts of the union use the
+// (author_id, create_date) indexes.
+func (r *Storage) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error) {
+	op := "internal.storage.db.GetUserActivity()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	args := []any{authorID, limit, unpublished}
+	where := ""
+	if after != nil {
+		where = "WHERE (create_date, kind, id) < ($4, $5, $6)"
+		args = append(args, after.CreateDate, string(after.Kind), after.ID)
+	}
+
+	queryGetActivityKeys := `SELECT kind, id
+							FROM (
+								SELECT 'post' AS kind, post_id AS id, create_date
+								FROM Posts
+								WHERE author_id = $1 AND (status = 'published' OR $3)
+								UNION ALL
+								SELECT 'comment' AS kind, comment_id AS id, create_date
+								FROM Comments
+								WHERE author_id = $1
+							) activity
+							` + where + `
+							ORDER BY create_date DESC, kind DESC, id DESC
+							LIMIT $2`
+
+	queryGetPosts := `SELECT post_id, author_id, title, text, comments_enabled, create_date, comments_count, last_comment_at, score, upvotes, downvotes, status, publish_at, format, max_comment_depth, comment_depth_mode
+							FROM Posts
+							WHERE post_id = ANY($1)`
+
+	queryGetComments := `SELECT c.comment_id, c.author_id, c.post_id, c.parent_id, c.path, c.text, c.format, c.create_date, c.score,
+								counts.replies_count, counts.descendants_count, p.title AS post_title
+							FROM Comments c
+							JOIN Posts p ON p.post_id = c.post_id
+							` + commentCounts + `
+							WHERE c.comment_id = ANY($1)`
+
+	var keys []struct {
+		Kind model.ActivityKind `db:"kind"`
+		ID   int64              `db:"id"`
+	}
+	err := r.db.SelectContext(ctx, &keys, queryGetActivityKeys, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s:%w", op, err)
+	}
+
+	var postIDs, commentIDs []int64
+	for _, key := range keys {
+		if key.Kind == model.ActivityComment {
+			commentIDs = append(commentIDs, key.ID)
+		} else {
+			postIDs = append(postIDs, key.ID)
+		}
+	}
+
+	posts := make(map[int64]*model.Post, len(postIDs))
+	if len(postIDs) > 0 {
+		var rows []*model.Post
+		err = r.db.SelectContext(ctx, &rows, queryGetPosts, postIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+		for _, post := range rows {
+			posts[post.ID] = post
+		}
+	}
+
+	type commentRow struct {
+		model.Comment
+		PostTitle string `db:"post_title"`
+	}
+	comments := make(map[int64]*commentRow, len(commentIDs))
+	if len(commentIDs) > 0 {
+		var rows []*commentRow
+		err = r.db.SelectContext(ctx, &rows, queryGetComments, commentIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%w", op, err)
+		}
+		for _, row := range rows {
+			comments[row.ID] = row
+		}
+	}
+
+	// the items keep the order of the keys, the ones deleted in between are skipped
+	items := make([]*model.ActivityItem, 0, len(keys))
+	for _, key := range keys {
+		if key.Kind == model.ActivityComment {
+			if row, ok := comments[key.ID]; ok {
+				items = append(items, &model.ActivityItem{Comment: &row.Comment, PostTitle: row.PostTitle})
+			}
+			continue
+		}
+		if post, ok := posts[key.ID]; ok {
+			items = append(items, &model.ActivityItem{Post: post, PostTitle: post.Title})
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return items, nil
+}
@@ -1,17 +1,26 @@
 package inmemory
 
 import (
+	"cmp"
 	"context"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
 	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/search"
 	"github.com/rs/zerolog/log"
 )
 
 type Storage struct {
+	// mu guards the posts, the comments and their votes, the scheduler
+	// publishes the posts concurrently with the mutations. The storage hands
+	// out copies, the resolvers read them while the mutations go on.
+	mu               sync.RWMutex
 	postsLastIndex   int64
 	commentLastIndex int64
 	comments         map[int64][]*model.Comment
@@ -19,6 +28,24 @@ type Storage struct {
 	repliesByPath    map[string][]*model.Comment
 	post             map[int64]*model.Post
 	posts            []*model.Post
+	postTags         map[int64][]string
+	postsByTag       map[string][]*model.Post
+	// the posts and the comments of every author in the order they were added
+	activity map[uuid.UUID][]*model.ActivityItem
+
+	scheduled map[int64]*model.Post
+
+	postVotes    map[int64]map[uuid.UUID]model.VoteValue
+	commentVotes map[int64]map[uuid.UUID]model.VoteValue
+
+	reactionsMu sync.Mutex
+	reactions   map[model.ReactionKey]map[string]map[uuid.UUID]struct{}
+
+	// the inverted indexes of the search, a term to the weights of the
+	// posts or the comments it occurs in
+	searchMu     sync.RWMutex
+	postIndex    map[string]map[int64]float64
+	commentIndex map[string]map[int64]float64
 }
 
 func NewStorage() *Storage {
@@ -30,60 +57,104 @@ func NewStorage() *Storage {
 		repliesByPath:    make(map[string][]*model.Comment),
 		post:             make(map[int64]*model.Post),
 		posts:            make([]*model.Post, 0),
+		postTags:         make(map[int64][]string),
+		postsByTag:       make(map[string][]*model.Post),
+		activity:         make(map[uuid.UUID][]*model.ActivityItem),
+		scheduled:        make(map[int64]*model.Post),
+		postVotes:        make(map[int64]map[uuid.UUID]model.VoteValue),
+		commentVotes:     make(map[int64]map[uuid.UUID]model.VoteValue),
+		reactions:        make(map[model.ReactionKey]map[string]map[uuid.UUID]struct{}),
+		postIndex:        make(map[string]map[int64]float64),
+		commentIndex:     make(map[string]map[int64]float64),
 	}
 }
 
 func (r *Storage) AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error) {
 	op := "internal.storage.inmemory.AddPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 	post := &model.Post{
-		AuthorID:        newPost.AuthorID,
-		Title:           newPost.Title,
-		Text:            newPost.Text,
-		CommentsEnabled: newPost.CommentsEnabled,
-		CreateDate:      time.Now(),
+		AuthorID:         newPost.AuthorID,
+		Title:            newPost.Title,
+		Text:             newPost.Text,
+		CommentsEnabled:  newPost.CommentsEnabled,
+		CreateDate:       newPost.CreateDate,
+		Status:           newPost.Status,
+		PublishAt:        newPost.PublishAt,
+		Format:           newPost.Format,
+		MaxCommentDepth:  newPost.MaxCommentDepth,
+		CommentDepthMode: newPost.CommentDepthMode,
+	}
+	if post.CreateDate.IsZero() {
+		post.CreateDate = time.Now()
+	}
+	if post.Status == model.PostPublished && post.PublishAt == nil {
+		post.PublishAt = &post.CreateDate
 	}
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	postID := r.postsLastIndex + 1
 	r.postsLastIndex += 1
 	post.ID = postID
 	r.post[postID] = post
 	r.posts = append(r.posts, post)
+	r.indexPost(post)
+	if post.Status == model.PostScheduled {
+		r.scheduled[postID] = post
+	}
+	r.activity[post.AuthorID] = append(r.activity[post.AuthorID], &model.ActivityItem{Post: post, PostTitle: post.Title})
 
-	log.Debug().Msgf("%s end", op)
-	return post, nil
+	if len(newPost.Tags) > 0 {
+		tags := slices.Clone(newPost.Tags)
+		slices.Sort(tags)
+		r.postTags[postID] = tags
+		for _, tag := range tags {
+			r.postsByTag[tag] = append(r.postsByTag[tag], post)
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return copyPost(post), nil
 }
 
 func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error) {
 	op := "internal.storage.inmemory.AddComment()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	comment := &model.Comment{
 		AuthorID:   newComment.AuthorID,
 		PostID:     postID,
 		ParentID:   newComment.ParentID,
 		Text:       newComment.Text,
-		CreateDate: time.Now(),
+		Format:     newComment.Format,
+		CreateDate: newComment.CreateDate,
+	}
+	if comment.CreateDate.IsZero() {
+		comment.CreateDate = time.Now()
 	}
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	post, ok := r.post[postID]
-	if !ok {
+	if !ok || post.Status != model.PostPublished {
 		return nil, errs.ErrPostNotExist
 	}
 
@@ -98,13 +169,26 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 			if parentComment.PostID != postID {
 				return nil, errs.ErrParentCommentNotExist
 			}
-			log.Info().Msgf("parentPath: %s", parentPath)
+			log.Ctx(ctx).Debug().Msgf("parentPath: %s", parentPath)
 		} else {
 			return nil, errs.ErrParentCommentNotExist
 		}
+
+		// the same limit as in the postgres storage, the level is the length
+		// of the path
+		parentPath, ok = post.CommentDepth(newComment.Depth).ReplyParent(parentPath)
+		if !ok {
+			return nil, errs.ErrCommentTooDeep
+		}
+		parentID, err := model.PathCommentID(parentPath)
+		if err != nil {
+			return nil, err
+		}
+		comment.ParentID = parentID
+	}
+	if comment.ParentID != nil {
 		r.repliesByPath[parentPath] = append(r.repliesByPath[parentPath], comment)
 		parentPath += "."
-
 	} else {
 		r.comments[postID] = append(r.comments[postID], comment)
 	}
@@ -117,97 +201,321 @@ func (r *Storage) AddComment(ctx context.Context, postID int64, newComment *mode
 	comment.Path = path
 
 	r.comment[commentID] = comment
+	r.indexComment(comment)
+	r.activity[comment.AuthorID] = append(r.activity[comment.AuthorID], &model.ActivityItem{Comment: comment, PostTitle: post.Title})
+
+	for _, id := range comment.AncestorIDs() {
+		if ancestor, ok := r.comment[id]; ok {
+			ancestor.DescendantsCount++
+		}
+	}
+	if comment.ParentID != nil {
+		r.comment[*comment.ParentID].RepliesCount++
+	}
 
-	log.Debug().Msgf("%s end", op)
-	return comment, nil
+	post.CommentsCount++
+	if post.LastCommentAt == nil || comment.CreateDate.After(*post.LastCommentAt) {
+		post.LastCommentAt = &comment.CreateDate
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return copyComment(comment), nil
 }
 
 func (r *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
 	op := "internal.storage.inmemory.UpdateEnableCommentToPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	post, ok := r.post[postID]
 	if !ok {
 		return nil, errs.ErrPostNotExist
 	}
 
+	// the post of another author doesn't exist for the user unless it is published
 	if post.AuthorID != authorID {
-		return nil, errs.ErrUnauthorizedAccess
+		if post.Status == model.PostPublished {
+			return nil, errs.ErrUnauthorizedAccess
+		}
+		return nil, errs.ErrPostNotExist
 	}
 
 	post.CommentsEnabled = commentsEnabled
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return copyPost(post), nil
+}
+
+func (r *Storage) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	op := "internal.storage.inmemory.PublishPost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.setPostStatus(ctx, postID, authorID, model.PostPublished, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return post, nil
 }
 
-func (r *Storage) GetAllPosts(ctx context.Context) ([]*model.Post, error) {
+func (r *Storage) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	op := "internal.storage.inmemory.SchedulePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	post, err := r.setPostStatus(ctx, postID, authorID, model.PostScheduled, publishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return post, nil
+}
+
+// setPostStatus moves the unpublished post of the author to the status, the
+// errors are the same as the db storage returns.
+func (r *Storage) setPostStatus(ctx context.Context, postID int64, authorID uuid.UUID, status model.PostStatus, publishAt time.Time) (*model.Post, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.post[postID]
+	if !ok {
+		return nil, errs.ErrPostNotExist
+	}
+
+	// the post of another author doesn't exist for the user unless it is published
+	if post.AuthorID != authorID {
+		if post.Status == model.PostPublished {
+			return nil, errs.ErrUnauthorizedAccess
+		}
+		return nil, errs.ErrPostNotExist
+	}
+	if post.Status == model.PostPublished {
+		return nil, errs.ErrPostAlreadyPublished
+	}
+
+	post.Status = status
+	post.PublishAt = &publishAt
+	if status == model.PostScheduled {
+		r.scheduled[postID] = post
+	} else {
+		delete(r.scheduled, postID)
+	}
+	return copyPost(post), nil
+}
+
+func (r *Storage) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	op := "internal.storage.inmemory.PublishDuePosts()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var posts []*model.Post
+	for id, post := range r.scheduled {
+		if post.PublishAt.After(now) {
+			continue
+		}
+		post.Status = model.PostPublished
+		delete(r.scheduled, id)
+		posts = append(posts, copyPost(post))
+	}
+	// the same order as the db storage: the subscribers get the posts in
+	// the order they were scheduled for
+	slices.SortFunc(posts, func(a, b *model.Post) int {
+		if c := a.PublishAt.Compare(*b.PublishAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return posts, nil
+}
+
+func (r *Storage) GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error) {
 	op := "internal.storage.inmemory.GetAllPosts()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	if len(r.posts) == 0 {
 		return nil, errs.ErrPostsNotExist
 	}
 
-	log.Debug().Msgf("%s end", op)
+	candidates := r.posts
+	if opts.Tag != "" {
+		candidates = r.postsByTag[opts.Tag]
+	}
+	posts := make([]*model.Post, 0, len(candidates))
+	for _, post := range candidates {
+		if post.VisibleTo(opts.ViewerID) && opts.Filter.Match(post) {
+			posts = append(posts, copyPost(post))
+		}
+	}
+	if opts.OrderBy != "" {
+		slices.SortStableFunc(posts, func(a, b *model.Post) int {
+			return comparePosts(a, b, opts)
+		})
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
-	return r.posts, nil
+	return posts, nil
+}
+
+func copyPost(post *model.Post) *model.Post {
+	c := *post
+	return &c
+}
+
+func copyComment(comment *model.Comment) *model.Comment {
+	c := *comment
+	return &c
+}
+
+func copyComments(comments []*model.Comment) []*model.Comment {
+	copies := make([]*model.Comment, len(comments))
+	for i, comment := range comments {
+		copies[i] = copyComment(comment)
+	}
+	return copies
+}
+
+func (r *Storage) GetAllPostsUnfiltered(ctx context.Context) ([]*model.Post, error) {
+	op := "internal.storage.inmemory.GetAllPostsUnfiltered()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	posts := make([]*model.Post, len(r.posts))
+	for i, post := range r.posts {
+		posts[i] = copyPost(post)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return posts, nil
+}
+
+// comparePosts orders the posts like the db storage does: posts without
+// comments are the last ones, equal posts are ordered by id.
+func comparePosts(a, b *model.Post, opts model.PostsOptions) int {
+	var c int
+	switch opts.OrderBy {
+	case model.PostOrderCommentsCount:
+		c = cmp.Compare(a.CommentsCount, b.CommentsCount)
+	case model.PostOrderScore:
+		c = cmp.Compare(a.Score, b.Score)
+	case model.PostOrderLastCommentAt:
+		switch {
+		case a.LastCommentAt == nil && b.LastCommentAt == nil:
+		case a.LastCommentAt == nil:
+			return 1
+		case b.LastCommentAt == nil:
+			return -1
+		default:
+			c = a.LastCommentAt.Compare(*b.LastCommentAt)
+		}
+	default:
+		c = a.CreateDate.Compare(b.CreateDate)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+	if opts.Desc {
+		return -c
+	}
+	return c
 }
 
 func (r *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error) {
 	op := "internal.storage.inmemory.GetPost()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	post, ok := r.post[postID]
 
 	if !ok {
 		return nil, errs.ErrPostNotExist
 	}
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 
-	return post, nil
+	return copyPost(post), nil
 }
 
 func (r *Storage) GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
 	op := "internal.storage.inmemory.GetCommentsBranch()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return nil, ctx.Err()
 	default:
 	}
 
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	if path == "" {
 		if v, ok := r.comments[postID]; ok {
-			return v, nil
+			return copyComments(v), nil
 		}
 		return nil, errs.ErrCommentsNotExist
 	}
@@ -221,22 +529,25 @@ func (r *Storage) GetCommentsBranch(ctx context.Context, postID int64, path stri
 		return nil, errs.ErrCommentsNotExist
 	}
 
-	return comments, nil
+	return copyComments(comments), nil
 
 }
 
 func (r *Storage) GetCommentPath(ctx context.Context, commentID int64) (string, error) {
 	op := "internal.storage.inmemory.GetCommentPath()"
 
-	log.Debug().Msgf("%s start", op)
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
 
 	select {
 	case <-ctx.Done():
-		log.Warn().Msgf("%s canceled", op)
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
 		return "", ctx.Err()
 	default:
 	}
 
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	comment, ok := r.comment[commentID]
 	if !ok {
 		return "", errs.ErrCommentsNotExist
@@ -244,6 +555,559 @@ func (r *Storage) GetCommentPath(ctx context.Context, commentID int64) (string,
 
 	path := comment.Path
 
-	log.Debug().Msgf("%s end", op)
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
 	return path, nil
 }
+
+func (r *Storage) GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error) {
+	op := "internal.storage.inmemory.GetCommentsBranches()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	branches := make(map[model.BranchKey][]*model.Comment, len(keys))
+	for _, key := range keys {
+		if key.Path == "" {
+			if v, ok := r.comments[key.PostID]; ok {
+				branches[key] = copyComments(v)
+			}
+			continue
+		}
+		if v, ok := r.repliesByPath[key.Path]; ok && len(v) > 0 {
+			branches[key] = copyComments(v)
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return branches, nil
+}
+
+func (r *Storage) GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+	op := "internal.storage.inmemory.GetCommentPaths()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	paths := make(map[int64]string, len(commentIDs))
+	for _, id := range commentIDs {
+		if comment, ok := r.comment[id]; ok {
+			paths[id] = comment.Path
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return paths, nil
+}
+
+func (r *Storage) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	op := "internal.storage.inmemory.VotePost()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	post, ok := r.post[postID]
+	if !ok || post.Status != model.PostPublished {
+		return nil, errs.ErrPostNotExist
+	}
+
+	votes := r.postVotes[postID]
+	if votes == nil {
+		votes = make(map[uuid.UUID]model.VoteValue)
+		r.postVotes[postID] = votes
+	}
+
+	up, down, score := model.VoteDelta(votes[userID], value)
+	if value == model.VoteNone {
+		delete(votes, userID)
+	} else {
+		votes[userID] = value
+	}
+
+	post.Upvotes += up
+	post.Downvotes += down
+	post.Score += score
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return copyPost(post), nil
+}
+
+func (r *Storage) GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error) {
+	op := "internal.storage.inmemory.GetPostVotes()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	votes := make(map[int64]model.VoteValue, len(postIDs))
+	for _, id := range postIDs {
+		if v, ok := r.postVotes[id][userID]; ok {
+			votes[id] = v
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return votes, nil
+}
+
+func (r *Storage) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	op := "internal.storage.inmemory.VoteComment()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment, ok := r.comment[commentID]
+	if !ok {
+		return nil, errs.ErrCommentNotExist
+	}
+
+	votes := r.commentVotes[commentID]
+	if votes == nil {
+		votes = make(map[uuid.UUID]model.VoteValue)
+		r.commentVotes[commentID] = votes
+	}
+
+	_, _, score := model.VoteDelta(votes[userID], value)
+	if value == model.VoteNone {
+		delete(votes, userID)
+	} else {
+		votes[userID] = value
+	}
+
+	comment.Score += score
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return copyComment(comment), nil
+}
+
+func (r *Storage) GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error) {
+	op := "internal.storage.inmemory.GetCommentVotes()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	votes := make(map[int64]model.VoteValue, len(commentIDs))
+	for _, id := range commentIDs {
+		if v, ok := r.commentVotes[id][userID]; ok {
+			votes[id] = v
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return votes, nil
+}
+
+// reactionPostID checks that the target exists and returns the post it belongs
+// to, only the published posts can get reactions.
+func (r *Storage) reactionPostID(key model.ReactionKey) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if key.Target == model.ReactionTargetComment {
+		comment, ok := r.comment[key.ID]
+		if !ok {
+			return 0, errs.ErrCommentNotExist
+		}
+		return comment.PostID, nil
+	}
+
+	if post, ok := r.post[key.ID]; !ok || post.Status != model.PostPublished {
+		return 0, errs.ErrPostNotExist
+	}
+	return key.ID, nil
+}
+
+func (r *Storage) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.storage.inmemory.AddReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return 0, false, ctx.Err()
+	default:
+	}
+
+	postID, err := r.reactionPostID(key)
+	if err != nil {
+		return 0, false, err
+	}
+
+	r.reactionsMu.Lock()
+	defer r.reactionsMu.Unlock()
+
+	byEmoji := r.reactions[key]
+	if byEmoji == nil {
+		byEmoji = make(map[string]map[uuid.UUID]struct{})
+		r.reactions[key] = byEmoji
+	}
+	users := byEmoji[emoji]
+	if users == nil {
+		users = make(map[uuid.UUID]struct{})
+		byEmoji[emoji] = users
+	}
+	if _, ok := users[userID]; ok {
+		return postID, false, nil
+	}
+	users[userID] = struct{}{}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, true, nil
+}
+
+func (r *Storage) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	op := "internal.storage.inmemory.RemoveReaction()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return 0, false, ctx.Err()
+	default:
+	}
+
+	postID, err := r.reactionPostID(key)
+	if err != nil {
+		return 0, false, err
+	}
+
+	r.reactionsMu.Lock()
+	defer r.reactionsMu.Unlock()
+
+	byEmoji := r.reactions[key]
+	if _, ok := byEmoji[emoji][userID]; !ok {
+		return postID, false, nil
+	}
+	delete(byEmoji[emoji], userID)
+	if len(byEmoji[emoji]) == 0 {
+		delete(byEmoji, emoji)
+	}
+	if len(byEmoji) == 0 {
+		delete(r.reactions, key)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return postID, true, nil
+}
+
+func (r *Storage) GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+	op := "internal.storage.inmemory.GetReactions()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.reactionsMu.Lock()
+	defer r.reactionsMu.Unlock()
+
+	reactions := make(map[model.ReactionKey][]model.Reaction, len(keys))
+	for _, key := range keys {
+		for emoji, users := range r.reactions[key] {
+			_, reacted := users[userID]
+			reactions[key] = append(reactions[key], model.Reaction{
+				Emoji:       emoji,
+				Count:       int64(len(users)),
+				ReactedByMe: reacted,
+			})
+		}
+		// the same order as the db storage: the most popular first
+		slices.SortFunc(reactions[key], func(a, b model.Reaction) int {
+			if c := cmp.Compare(b.Count, a.Count); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Emoji, b.Emoji)
+		})
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return reactions, nil
+}
+
+// The weights of the words of the title and of the text, the same as the
+// default weights of the A and B labels of ts_rank in the db storage. The
+// comments have the text only.
+const (
+	titleWeight = 1.0
+	textWeight  = 0.4
+)
+
+// snippetWords is the length of a snippet, the db storage uses MaxWords=30.
+const snippetWords = 30
+
+func addToIndex(index map[string]map[int64]float64, id int64, text string, weight float64) {
+	for _, term := range search.Terms(text) {
+		if index[term] == nil {
+			index[term] = make(map[int64]float64)
+		}
+		index[term][id] += weight
+	}
+}
+
+func (r *Storage) indexPost(post *model.Post) {
+	r.searchMu.Lock()
+	defer r.searchMu.Unlock()
+
+	addToIndex(r.postIndex, post.ID, post.Title, titleWeight)
+	addToIndex(r.postIndex, post.ID, post.Text, textWeight)
+}
+
+func (r *Storage) indexComment(comment *model.Comment) {
+	r.searchMu.Lock()
+	defer r.searchMu.Unlock()
+
+	addToIndex(r.commentIndex, comment.ID, comment.Text, textWeight)
+}
+
+// lookup returns the ids containing every term with their ranks, the sums of
+// the weights of the terms.
+func lookup(index map[string]map[int64]float64, terms map[string]struct{}) map[int64]float64 {
+	var ranks map[int64]float64
+	for term := range terms {
+		ids := index[term]
+		if ranks == nil {
+			ranks = make(map[int64]float64, len(ids))
+			for id, weight := range ids {
+				ranks[id] = weight
+			}
+			continue
+		}
+		for id := range ranks {
+			weight, ok := ids[id]
+			if !ok {
+				delete(ranks, id)
+				continue
+			}
+			ranks[id] += weight
+		}
+	}
+	return ranks
+}
+
+func (r *Storage) Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	op := "internal.storage.inmemory.Search()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	terms := make(map[string]struct{})
+	for _, term := range search.Terms(opts.Query) {
+		terms[term] = struct{}{}
+	}
+	if len(terms) == 0 {
+		return []*model.SearchHit{}, nil
+	}
+
+	index := r.postIndex
+	if opts.Type == model.SearchComments {
+		index = r.commentIndex
+	}
+
+	r.searchMu.RLock()
+	ranks := lookup(index, terms)
+	r.searchMu.RUnlock()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]int64, 0, len(ranks))
+	for id := range ranks {
+		if opts.Type != model.SearchComments && r.post[id].Status != model.PostPublished {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	// the same order as the db storage: the best first, then the newest
+	slices.SortFunc(ids, func(a, b int64) int {
+		if c := cmp.Compare(ranks[b], ranks[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(b, a)
+	})
+	ids = ids[min(opts.Offset, len(ids)):]
+	ids = ids[:min(opts.Limit, len(ids))]
+
+	hits := make([]*model.SearchHit, len(ids))
+	for i, id := range ids {
+		hit := &model.SearchHit{Rank: ranks[id]}
+		if opts.Type == model.SearchComments {
+			hit.Comment = copyComment(r.comment[id])
+			hit.Snippet = search.Highlight(search.Snippet(hit.Comment.Text, terms, snippetWords))
+		} else {
+			hit.Post = copyPost(r.post[id])
+			hit.Snippet = search.Highlight(search.Snippet(hit.Post.Title+" "+hit.Post.Text, terms, snippetWords))
+		}
+		hits[i] = hit
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return hits, nil
+}
+
+func (r *Storage) GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error) {
+	op := "internal.storage.inmemory.GetPostTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tags := make(map[int64][]string, len(postIDs))
+	for _, id := range postIDs {
+		if postTags, ok := r.postTags[id]; ok {
+			tags[id] = postTags
+		}
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+func (r *Storage) GetTags(ctx context.Context) ([]model.Tag, error) {
+	op := "internal.storage.inmemory.GetTags()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tags := make([]model.Tag, 0, len(r.postsByTag))
+	for name, posts := range r.postsByTag {
+		var count int64
+		for _, post := range posts {
+			if post.Status == model.PostPublished {
+				count++
+			}
+		}
+		if count > 0 {
+			tags = append(tags, model.Tag{Name: name, PostsCount: count})
+		}
+	}
+	// the same order as the db storage: the most used first
+	slices.SortFunc(tags, func(a, b model.Tag) int {
+		if c := cmp.Compare(b.PostsCount, a.PostsCount); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return tags, nil
+}
+
+func (r *Storage) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error) {
+	op := "internal.storage.inmemory.GetUserActivity()"
+
+	log.Ctx(ctx).Debug().Msgf("%s start", op)
+
+	select {
+	case <-ctx.Done():
+		log.Ctx(ctx).Warn().Msgf("%s canceled", op)
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// the items were added in the order of their creation, so the feed is
+	// the index read from the end
+	activity := r.activity[authorID]
+	items := make([]*model.ActivityItem, 0, min(limit, len(activity)))
+	for i := len(activity) - 1; i >= 0 && len(items) < limit; i-- {
+		if after != nil && !after.Before(activity[i].Key()) {
+			continue
+		}
+		if post := activity[i].Post; post != nil && post.Status != model.PostPublished && !unpublished {
+			continue
+		}
+		item := *activity[i]
+		if item.Post != nil {
+			item.Post = copyPost(item.Post)
+		} else {
+			item.Comment = copyComment(item.Comment)
+		}
+		items = append(items, &item)
+	}
+
+	log.Ctx(ctx).Debug().Msgf("%s end", op)
+	return items, nil
+}
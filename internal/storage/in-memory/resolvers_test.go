@@ -0,0 +1,226 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addPost(t *testing.T, storage *Storage, newPost model.NewPost) *model.Post {
+	t.Helper()
+
+	if newPost.AuthorID == uuid.Nil {
+		newPost.AuthorID = uuid.New()
+	}
+	if newPost.Status == "" {
+		newPost.Status = model.PostPublished
+	}
+	post, err := storage.AddPost(context.Background(), &newPost)
+	require.NoError(t, err)
+	return post
+}
+
+// TestVoteConcurrently runs the votes together with the readers of the scores,
+// go test -race reports the unguarded ones.
+func TestVoteConcurrently(t *testing.T) {
+	ctx := context.Background()
+	storage := NewStorage()
+	post := addPost(t, storage, model.NewPost{Title: "title", Text: "text", CommentsEnabled: true})
+	comment, err := storage.AddComment(ctx, post.ID, &model.NewComment{AuthorID: uuid.New(), Text: "text"})
+	require.NoError(t, err)
+
+	const voters = 20
+	var wg sync.WaitGroup
+	for range voters {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			userID := uuid.New()
+			_, err := storage.VotePost(ctx, post.ID, userID, model.VoteUp)
+			assert.NoError(t, err)
+			_, err = storage.VoteComment(ctx, comment.ID, userID, model.VoteUp)
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			posts, err := storage.GetAllPosts(ctx, model.PostsOptions{OrderBy: model.PostOrderScore, Desc: true})
+			assert.NoError(t, err)
+			_ = posts[0].Score
+			branch, err := storage.GetCommentsBranch(ctx, post.ID, "")
+			assert.NoError(t, err)
+			_ = branch[0].Score
+		}()
+	}
+	wg.Wait()
+
+	got, err := storage.GetPost(ctx, post.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, voters, got.Score)
+	assert.EqualValues(t, voters, got.Upvotes)
+
+	branch, err := storage.GetCommentsBranch(ctx, post.ID, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, voters, branch[0].Score)
+}
+
+// TestPublishConcurrently runs the scheduler together with the readers of the
+// statuses.
+func TestPublishConcurrently(t *testing.T) {
+	ctx := context.Background()
+	storage := NewStorage()
+	publishAt := time.Now().Add(time.Hour)
+
+	const posts = 20
+	for range posts {
+		addPost(t, storage, model.NewPost{Title: "title", Text: "text", Status: model.PostScheduled, PublishAt: &publishAt})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		published, err := storage.PublishDuePosts(ctx, publishAt)
+		assert.NoError(t, err)
+		assert.Len(t, published, posts)
+	}()
+	go func() {
+		defer wg.Done()
+		for id := range int64(posts) {
+			_, err := storage.GetPost(ctx, id+1)
+			assert.NoError(t, err)
+		}
+		_, err := storage.GetAllPosts(ctx, model.PostsOptions{})
+		assert.NoError(t, err)
+		_, err = storage.Search(ctx, model.SearchOptions{Query: "title", Limit: posts})
+		assert.NoError(t, err)
+	}()
+	wg.Wait()
+
+	all, err := storage.GetAllPosts(ctx, model.PostsOptions{})
+	require.NoError(t, err)
+	assert.Len(t, all, posts)
+}
+
+func TestReactionChanged(t *testing.T) {
+	ctx := context.Background()
+	storage := NewStorage()
+	post := addPost(t, storage, model.NewPost{Title: "title", Text: "text"})
+	key := model.ReactionKey{Target: model.ReactionTargetPost, ID: post.ID}
+	userID := uuid.New()
+
+	_, changed, err := storage.RemoveReaction(ctx, key, userID, "👍")
+	require.NoError(t, err)
+	assert.False(t, changed, "there is no reaction to remove")
+
+	_, changed, err = storage.AddReaction(ctx, key, userID, "👍")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	_, changed, err = storage.AddReaction(ctx, key, userID, "👍")
+	require.NoError(t, err)
+	assert.False(t, changed, "the reaction is already there")
+
+	_, changed, err = storage.RemoveReaction(ctx, key, userID, "👍")
+	require.NoError(t, err)
+	assert.True(t, changed)
+}
+
+func TestSearchComments(t *testing.T) {
+	ctx := context.Background()
+	storage := NewStorage()
+	post := addPost(t, storage, model.NewPost{Title: "title", Text: "text", CommentsEnabled: true})
+	_, err := storage.AddComment(ctx, post.ID, &model.NewComment{AuthorID: uuid.New(), Text: "cats \x02and\x03 dogs"})
+	require.NoError(t, err)
+
+	hits, err := storage.Search(ctx, model.SearchOptions{Query: "cats", Type: model.SearchComments, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, textWeight, hits[0].Rank, "comments are weighted as texts")
+	assert.Equal(t, "<b>cats</b> and dogs", hits[0].Snippet)
+}
+
+func TestCommentDepth(t *testing.T) {
+	ctx := context.Background()
+
+	// addBranch adds a chain of replies of the given length, each one to the
+	// previous one.
+	addBranch := func(t *testing.T, storage *Storage, postID int64, length int, depth model.CommentDepth) []*model.Comment {
+		t.Helper()
+
+		var parentID *int64
+		branch := make([]*model.Comment, 0, length)
+		for range length {
+			comment, err := storage.AddComment(ctx, postID, &model.NewComment{AuthorID: uuid.New(), ParentID: parentID, Text: "text", Depth: depth})
+			require.NoError(t, err)
+			branch = append(branch, comment)
+			parentID = &comment.ID
+		}
+		return branch
+	}
+
+	t.Run("Reject", func(t *testing.T) {
+		storage := NewStorage()
+		post := addPost(t, storage, model.NewPost{Title: "title", Text: "text", CommentsEnabled: true})
+		depth := model.CommentDepth{Max: 2, Mode: model.DepthReject}
+		branch := addBranch(t, storage, post.ID, 2, depth)
+
+		_, err := storage.AddComment(ctx, post.ID, &model.NewComment{AuthorID: uuid.New(), ParentID: &branch[1].ID, Text: "text", Depth: depth})
+		assert.ErrorIs(t, err, errs.ErrCommentTooDeep)
+	})
+
+	t.Run("Flatten", func(t *testing.T) {
+		storage := NewStorage()
+		post := addPost(t, storage, model.NewPost{Title: "title", Text: "text", CommentsEnabled: true})
+		depth := model.CommentDepth{Max: 2, Mode: model.DepthFlatten}
+		branch := addBranch(t, storage, post.ID, 2, depth)
+
+		reply, err := storage.AddComment(ctx, post.ID, &model.NewComment{AuthorID: uuid.New(), ParentID: &branch[1].ID, Text: "text", Depth: depth})
+		require.NoError(t, err)
+		require.NotNil(t, reply.ParentID)
+		assert.Equal(t, branch[0].ID, *reply.ParentID, "the reply goes to the deepest allowed ancestor")
+		assert.Equal(t, 2, reply.Level())
+
+		replies, err := storage.GetCommentsBranch(ctx, post.ID, branch[0].Path)
+		require.NoError(t, err)
+		require.Len(t, replies, 2)
+		assert.Equal(t, reply.ID, replies[1].ID)
+		assert.Equal(t, branch[1].ID, replies[0].ID)
+	})
+
+	t.Run("Post overrides the global limit", func(t *testing.T) {
+		storage := NewStorage()
+		maxDepth, mode := 3, model.DepthReject
+		post := addPost(t, storage, model.NewPost{Title: "title", Text: "text", CommentsEnabled: true, MaxCommentDepth: &maxDepth, CommentDepthMode: &mode})
+		global := model.CommentDepth{Max: 2, Mode: model.DepthFlatten}
+		branch := addBranch(t, storage, post.ID, 3, global)
+		assert.Equal(t, 3, branch[2].Level(), "the post allows deeper replies")
+
+		_, err := storage.AddComment(ctx, post.ID, &model.NewComment{AuthorID: uuid.New(), ParentID: &branch[2].ID, Text: "text", Depth: global})
+		assert.ErrorIs(t, err, errs.ErrCommentTooDeep, "the post rejects instead of flattening")
+	})
+}
+
+func TestUpdateEnableCommentOfAnotherAuthor(t *testing.T) {
+	ctx := context.Background()
+	storage := NewStorage()
+	draft := addPost(t, storage, model.NewPost{Title: "title", Text: "text", Status: model.PostDraft})
+	published := addPost(t, storage, model.NewPost{Title: "title", Text: "text"})
+	otherID := uuid.New()
+
+	_, err := storage.UpdateEnableCommentToPost(ctx, draft.ID, otherID, true)
+	assert.ErrorIs(t, err, errs.ErrPostNotExist, "the draft of another author is hidden")
+
+	_, err = storage.UpdateEnableCommentToPost(ctx, published.ID, otherID, true)
+	assert.ErrorIs(t, err, errs.ErrUnauthorizedAccess)
+
+	post, err := storage.UpdateEnableCommentToPost(ctx, draft.ID, draft.AuthorID, true)
+	require.NoError(t, err)
+	assert.True(t, post.CommentsEnabled)
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nabishec/ozon_habr_api/internal/model"
@@ -11,8 +12,27 @@ type StorageImp interface {
 	AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error)
 	AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error)
 	UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error)
-	GetAllPosts(ctx context.Context) ([]*model.Post, error)
+	PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error)
+	SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error)
+	PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error)
+	GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error)
+	// GetAllPostsUnfiltered returns the posts of every status in the order
+	// they were added, for export
+	GetAllPostsUnfiltered(ctx context.Context) ([]*model.Post, error)
 	GetPost(ctx context.Context, postID int64) (*model.Post, error)
+	VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error)
+	GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error)
 	GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error)
 	GetCommentPath(ctx context.Context, parentID int64) (string, error)
+	GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error)
+	GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error)
+	VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error)
+	GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error)
+	AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error)
+	RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error)
+	GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error)
+	Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error)
+	GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error)
+	GetTags(ctx context.Context) ([]model.Tag, error)
+	GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error)
 }
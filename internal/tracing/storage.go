@@ -0,0 +1,198 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nabishec/ozon_habr_api/internal/model"
+	"github.com/nabishec/ozon_habr_api/internal/storage"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Storage starts a span for every storage.StorageImp call.
+type Storage struct {
+	storage storage.StorageImp
+}
+
+var _ storage.StorageImp = (*Storage)(nil)
+
+func NewStorage(storage storage.StorageImp) *Storage {
+	return &Storage{storage: storage}
+}
+
+func (s *Storage) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, "storage."+method, trace.WithAttributes(attrs...))
+}
+
+func (s *Storage) AddPost(ctx context.Context, newPost *model.NewPost) (*model.Post, error) {
+	ctx, span := s.start(ctx, "AddPost")
+	post, err := s.storage.AddPost(ctx, newPost)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) AddComment(ctx context.Context, postID int64, newComment *model.NewComment) (*model.Comment, error) {
+	ctx, span := s.start(ctx, "AddComment", attribute.Int64("post.id", postID))
+	comment, err := s.storage.AddComment(ctx, postID, newComment)
+	End(span, err)
+	return comment, err
+}
+
+func (s *Storage) UpdateEnableCommentToPost(ctx context.Context, postID int64, authorID uuid.UUID, commentsEnabled bool) (*model.Post, error) {
+	ctx, span := s.start(ctx, "UpdateEnableCommentToPost", attribute.Int64("post.id", postID))
+	post, err := s.storage.UpdateEnableCommentToPost(ctx, postID, authorID, commentsEnabled)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) PublishPost(ctx context.Context, postID int64, authorID uuid.UUID) (*model.Post, error) {
+	ctx, span := s.start(ctx, "PublishPost", attribute.Int64("post.id", postID))
+	post, err := s.storage.PublishPost(ctx, postID, authorID)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) SchedulePost(ctx context.Context, postID int64, authorID uuid.UUID, publishAt time.Time) (*model.Post, error) {
+	ctx, span := s.start(ctx, "SchedulePost", attribute.Int64("post.id", postID))
+	post, err := s.storage.SchedulePost(ctx, postID, authorID, publishAt)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) PublishDuePosts(ctx context.Context, now time.Time) ([]*model.Post, error) {
+	ctx, span := s.start(ctx, "PublishDuePosts")
+	posts, err := s.storage.PublishDuePosts(ctx, now)
+	span.SetAttributes(attribute.Int("posts.published", len(posts)))
+	End(span, err)
+	return posts, err
+}
+
+func (s *Storage) GetAllPosts(ctx context.Context, opts model.PostsOptions) ([]*model.Post, error) {
+	ctx, span := s.start(ctx, "GetAllPosts", attribute.String("posts.order", string(opts.OrderBy)), attribute.Bool("posts.desc", opts.Desc), attribute.String("posts.tag", opts.Tag))
+	posts, err := s.storage.GetAllPosts(ctx, opts)
+	End(span, err)
+	return posts, err
+}
+
+func (s *Storage) GetAllPostsUnfiltered(ctx context.Context) ([]*model.Post, error) {
+	ctx, span := s.start(ctx, "GetAllPostsUnfiltered")
+	posts, err := s.storage.GetAllPostsUnfiltered(ctx)
+	End(span, err)
+	return posts, err
+}
+
+func (s *Storage) GetPost(ctx context.Context, postID int64) (*model.Post, error) {
+	ctx, span := s.start(ctx, "GetPost", attribute.Int64("post.id", postID))
+	post, err := s.storage.GetPost(ctx, postID)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) GetCommentsBranch(ctx context.Context, postID int64, path string) ([]*model.Comment, error) {
+	ctx, span := s.start(ctx, "GetCommentsBranch", attribute.Int64("post.id", postID), attribute.String("comment.path", path))
+	comments, err := s.storage.GetCommentsBranch(ctx, postID, path)
+	End(span, err)
+	return comments, err
+}
+
+func (s *Storage) GetCommentPath(ctx context.Context, commentID int64) (string, error) {
+	ctx, span := s.start(ctx, "GetCommentPath", attribute.Int64("comment.id", commentID))
+	path, err := s.storage.GetCommentPath(ctx, commentID)
+	End(span, err)
+	return path, err
+}
+
+func (s *Storage) GetCommentsBranches(ctx context.Context, keys []model.BranchKey) (map[model.BranchKey][]*model.Comment, error) {
+	ctx, span := s.start(ctx, "GetCommentsBranches", attribute.Int("batch.size", len(keys)))
+	branches, err := s.storage.GetCommentsBranches(ctx, keys)
+	End(span, err)
+	return branches, err
+}
+
+func (s *Storage) GetCommentPaths(ctx context.Context, commentIDs []int64) (map[int64]string, error) {
+	ctx, span := s.start(ctx, "GetCommentPaths", attribute.Int("batch.size", len(commentIDs)))
+	paths, err := s.storage.GetCommentPaths(ctx, commentIDs)
+	End(span, err)
+	return paths, err
+}
+
+func (s *Storage) VotePost(ctx context.Context, postID int64, userID uuid.UUID, value model.VoteValue) (*model.Post, error) {
+	ctx, span := s.start(ctx, "VotePost", attribute.Int64("post.id", postID), attribute.Int("vote.value", int(value)))
+	post, err := s.storage.VotePost(ctx, postID, userID, value)
+	End(span, err)
+	return post, err
+}
+
+func (s *Storage) GetPostVotes(ctx context.Context, userID uuid.UUID, postIDs []int64) (map[int64]model.VoteValue, error) {
+	ctx, span := s.start(ctx, "GetPostVotes", attribute.Int("batch.size", len(postIDs)))
+	votes, err := s.storage.GetPostVotes(ctx, userID, postIDs)
+	End(span, err)
+	return votes, err
+}
+
+func (s *Storage) VoteComment(ctx context.Context, commentID int64, userID uuid.UUID, value model.VoteValue) (*model.Comment, error) {
+	ctx, span := s.start(ctx, "VoteComment", attribute.Int64("comment.id", commentID), attribute.Int("vote.value", int(value)))
+	comment, err := s.storage.VoteComment(ctx, commentID, userID, value)
+	End(span, err)
+	return comment, err
+}
+
+func (s *Storage) GetCommentVotes(ctx context.Context, userID uuid.UUID, commentIDs []int64) (map[int64]model.VoteValue, error) {
+	ctx, span := s.start(ctx, "GetCommentVotes", attribute.Int("batch.size", len(commentIDs)))
+	votes, err := s.storage.GetCommentVotes(ctx, userID, commentIDs)
+	End(span, err)
+	return votes, err
+}
+
+func (s *Storage) AddReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	ctx, span := s.start(ctx, "AddReaction", attribute.String("reaction.target", string(key.Target)), attribute.Int64("reaction.target_id", key.ID))
+	postID, changed, err := s.storage.AddReaction(ctx, key, userID, emoji)
+	span.SetAttributes(attribute.Bool("reaction.changed", changed))
+	End(span, err)
+	return postID, changed, err
+}
+
+func (s *Storage) RemoveReaction(ctx context.Context, key model.ReactionKey, userID uuid.UUID, emoji string) (int64, bool, error) {
+	ctx, span := s.start(ctx, "RemoveReaction", attribute.String("reaction.target", string(key.Target)), attribute.Int64("reaction.target_id", key.ID))
+	postID, changed, err := s.storage.RemoveReaction(ctx, key, userID, emoji)
+	span.SetAttributes(attribute.Bool("reaction.changed", changed))
+	End(span, err)
+	return postID, changed, err
+}
+
+func (s *Storage) GetReactions(ctx context.Context, keys []model.ReactionKey, userID uuid.UUID) (map[model.ReactionKey][]model.Reaction, error) {
+	ctx, span := s.start(ctx, "GetReactions", attribute.Int("batch.size", len(keys)))
+	reactions, err := s.storage.GetReactions(ctx, keys, userID)
+	End(span, err)
+	return reactions, err
+}
+
+func (s *Storage) Search(ctx context.Context, opts model.SearchOptions) ([]*model.SearchHit, error) {
+	ctx, span := s.start(ctx, "Search", attribute.String("search.type", string(opts.Type)), attribute.Int("search.offset", opts.Offset))
+	hits, err := s.storage.Search(ctx, opts)
+	End(span, err)
+	return hits, err
+}
+
+func (s *Storage) GetPostTags(ctx context.Context, postIDs []int64) (map[int64][]string, error) {
+	ctx, span := s.start(ctx, "GetPostTags", attribute.Int("batch.size", len(postIDs)))
+	tags, err := s.storage.GetPostTags(ctx, postIDs)
+	End(span, err)
+	return tags, err
+}
+
+func (s *Storage) GetTags(ctx context.Context) ([]model.Tag, error) {
+	ctx, span := s.start(ctx, "GetTags")
+	tags, err := s.storage.GetTags(ctx)
+	End(span, err)
+	return tags, err
+}
+
+func (s *Storage) GetUserActivity(ctx context.Context, authorID uuid.UUID, after *model.ActivityKey, limit int, unpublished bool) ([]*model.ActivityItem, error) {
+	ctx, span := s.start(ctx, "GetUserActivity", attribute.Int("activity.limit", limit), attribute.Bool("activity.after", after != nil), attribute.Bool("activity.unpublished", unpublished))
+	items, err := s.storage.GetUserActivity(ctx, authorID, after, limit, unpublished)
+	End(span, err)
+	return items, err
+}
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE Posts
+    ADD COLUMN IF NOT EXISTS comments_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_comment_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE Posts p
+SET comments_count = c.comments_count,
+    last_comment_at = c.last_comment_at
+FROM (
+    SELECT post_id, COUNT(*) AS comments_count, MAX(create_date) AS last_comment_at
+    FROM Comments
+    GROUP BY post_id
+) c
+WHERE p.post_id = c.post_id;
+
+CREATE INDEX IF NOT EXISTS posts_comments_count_idx ON Posts (comments_count);
+
+CREATE INDEX IF NOT EXISTS posts_last_comment_at_idx ON Posts (last_comment_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS posts_last_comment_at_idx;
+
+DROP INDEX IF EXISTS posts_comments_count_idx;
+
+ALTER TABLE Posts
+    DROP COLUMN IF EXISTS last_comment_at,
+    DROP COLUMN IF EXISTS comments_count;
+
+-- +goose StatementEnd
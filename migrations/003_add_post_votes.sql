@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS post_votes (
+    post_id BIGINT NOT NULL REFERENCES posts(post_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    create_date TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_votes_user_idx ON post_votes (user_id);
+
+ALTER TABLE Posts
+    ADD COLUMN IF NOT EXISTS upvotes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS downvotes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS score BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS posts_score_idx ON Posts (score);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS posts_score_idx;
+
+ALTER TABLE Posts
+    DROP COLUMN IF EXISTS score,
+    DROP COLUMN IF EXISTS downvotes,
+    DROP COLUMN IF EXISTS upvotes;
+
+DROP TABLE IF EXISTS post_votes;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS comment_votes (
+    comment_id BIGINT NOT NULL REFERENCES comments(comment_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    create_date TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS comment_votes_user_idx ON comment_votes (user_id);
+
+ALTER TABLE Comments
+    ADD COLUMN IF NOT EXISTS score BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE Comments
+    DROP COLUMN IF EXISTS score;
+
+DROP TABLE IF EXISTS comment_votes;
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the content is mixed, so every text is indexed with both the russian and
+-- the english stemmers; the title weighs more than the text of a post
+ALTER TABLE Posts ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title) || to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', text) || to_tsvector('english', text), 'B')
+) STORED;
+
+CREATE INDEX posts_search_vector_idx ON Posts USING GIN (search_vector);
+
+ALTER TABLE Comments ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('russian', text) || to_tsvector('english', text)
+) STORED;
+
+CREATE INDEX comments_search_vector_idx ON Comments USING GIN (search_vector);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS comments_search_vector_idx;
+
+ALTER TABLE Comments DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS posts_search_vector_idx;
+
+ALTER TABLE Posts DROP COLUMN IF EXISTS search_vector;
+
+-- +goose StatementEnd
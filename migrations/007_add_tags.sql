@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS tags (
+    tag_id BIGSERIAL PRIMARY KEY,
+    name TEXT UNIQUE NOT NULL CHECK (char_length(name) BETWEEN 1 AND 32)
+);
+
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id BIGINT NOT NULL REFERENCES posts(post_id) ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags(tag_id) ON DELETE CASCADE,
+    PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX post_tags_tag_idx ON post_tags (tag_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS post_tags;
+
+DROP TABLE IF EXISTS tags;
+
+-- +goose StatementEnd
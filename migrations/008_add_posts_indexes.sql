@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the posts of an author in a date range
+CREATE INDEX IF NOT EXISTS posts_author_create_date_idx ON Posts (author_id, create_date);
+
+-- the posts in a date range and the default order of the posts
+CREATE INDEX IF NOT EXISTS posts_create_date_idx ON Posts (create_date);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS posts_create_date_idx;
+
+DROP INDEX IF EXISTS posts_author_create_date_idx;
+
+-- +goose StatementEnd
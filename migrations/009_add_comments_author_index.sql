@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- the comments of an author for the activity feed, newest first
+CREATE INDEX IF NOT EXISTS comments_author_create_date_idx ON Comments (author_id, create_date);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS comments_author_create_date_idx;
+
+-- +goose StatementEnd
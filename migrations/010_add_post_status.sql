@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE Posts
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published'
+        CHECK (status IN ('draft', 'scheduled', 'published')),
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE;
+
+-- the posts published before the statuses were published when they were created
+UPDATE Posts SET publish_at = create_date WHERE publish_at IS NULL AND status = 'published';
+
+-- the scheduler looks up the scheduled posts that are due
+CREATE INDEX IF NOT EXISTS posts_scheduled_publish_at_idx ON Posts (publish_at) WHERE status = 'scheduled';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS posts_scheduled_publish_at_idx;
+
+ALTER TABLE Posts
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS status;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE Posts
+    ADD COLUMN IF NOT EXISTS format TEXT NOT NULL DEFAULT 'plain'
+        CHECK (format IN ('plain', 'markdown'));
+
+ALTER TABLE Comments
+    ADD COLUMN IF NOT EXISTS format TEXT NOT NULL DEFAULT 'plain'
+        CHECK (format IN ('plain', 'markdown'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE Comments DROP COLUMN IF EXISTS format;
+
+ALTER TABLE Posts DROP COLUMN IF EXISTS format;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- NULL settings of a post mean the global ones
+ALTER TABLE Posts
+    ADD COLUMN IF NOT EXISTS max_comment_depth INTEGER
+        CHECK (max_comment_depth >= 0),
+    ADD COLUMN IF NOT EXISTS comment_depth_mode TEXT
+        CHECK (comment_depth_mode IN ('reject', 'flatten'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE Posts
+    DROP COLUMN IF EXISTS comment_depth_mode,
+    DROP COLUMN IF EXISTS max_comment_depth;
+
+-- +goose StatementEnd